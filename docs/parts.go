@@ -11,22 +11,36 @@ var (
 var (
 	//go:embed parts/data-sources/_access_request_forward.md
 	AccessRequestForwardDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_access_request_forwards.md
+	AccessRequestForwardsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_access_review_forward.md
 	AccessReviewForwardDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_access_review_forwards.md
+	AccessReviewForwardsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_accounts.md
 	AccountsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_agent_token.md
 	AgentTokenDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_agent_tokens.md
+	AgentTokensDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_application.md
 	ApplicationDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_audit_logs.md
+	AuditLogsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_bundle.md
 	BundleDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_bundles.md
+	BundlesDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_directory_groups.md
 	DirectoryGroupsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_integration.md
 	IntegrationDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_integrations.md
+	IntegrationsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_permissions.md
 	PermissionsDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_policies.md
+	PoliciesDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_policy.md
 	PolicyDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_resource.md
@@ -39,14 +53,26 @@ var (
 	RolesDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_user.md
 	UserDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_user_accounts.md
+	UserAccountsDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_users.md
 	UsersDataSourceMarkdownDescription string
 	//go:embed parts/data-sources/_workflow.md
 	WorkflowDataSourceMarkdownDescription string
+	//go:embed parts/data-sources/_workflows.md
+	WorkflowsDataSourceMarkdownDescription string
+)
+
+// List of ephemeral resources.
+var (
+	//go:embed parts/ephemeral-resources/_agent_token.md
+	AgentTokenEphemeralResourceMarkdownDescription string
 )
 
 // List of resources.
 var (
+	//go:embed parts/resources/_access_request.md
+	AccessRequestResourceMarkdownDescription string
 	//go:embed parts/resources/_access_request_forward.md
 	AccessRequestForwardResourceMarkdownDescription string
 	//go:embed parts/resources/_access_review_forward.md
@@ -55,22 +81,66 @@ var (
 	AgentTokenResourceMarkdownDescription string
 	//go:embed parts/resources/_bundle.md
 	BundleResourceMarkdownDescription string
+	//go:embed parts/resources/_bundle_role.md
+	BundleRoleResourceMarkdownDescription string
 	//go:embed parts/resources/_integration.md
 	IntegrationResourceMarkdownDescription string
 	//go:embed parts/resources/_integration_gitlab.md
 	IntegrationGitlabResourceMarkdownDescription string
+	//go:embed parts/resources/_integration_maintainer.md
+	IntegrationMaintainerResourceMarkdownDescription string
 	//go:embed parts/resources/_permission.md
 	PermissionResourceMarkdownDescription string
 	//go:embed parts/resources/_policy.md
 	PolicyResourceMarkdownDescription string
+	//go:embed parts/resources/_policy_attachment.md
+	PolicyAttachmentResourceMarkdownDescription string
 	//go:embed parts/resources/_resource.md
 	ResourceResourceMarkdownDescription string
+	//go:embed parts/resources/_resource_maintainer.md
+	ResourceMaintainerResourceMarkdownDescription string
 	//go:embed parts/resources/_resource_synced.md
 	ResourceSyncedResourceMarkdownDescription string
+	//go:embed parts/resources/_resources_synced_set.md
+	ResourcesSyncedSetResourceMarkdownDescription string
 	//go:embed parts/resources/_role.md
 	RoleResourceMarkdownDescription string
 	//go:embed parts/resources/_role_synced.md
 	RoleSyncedResourceMarkdownDescription string
+	//go:embed parts/resources/_roles_synced_set.md
+	RolesSyncedSetResourceMarkdownDescription string
+	//go:embed parts/resources/_user_account.md
+	UserAccountResourceMarkdownDescription string
 	//go:embed parts/resources/_workflow.md
 	WorkflowResourceMarkdownDescription string
 )
+
+// List of list resources.
+var (
+	//go:embed parts/list-resources/_agent_token.md
+	AgentTokenListResourceMarkdownDescription string
+	//go:embed parts/list-resources/_bundle.md
+	BundleListResourceMarkdownDescription string
+	//go:embed parts/list-resources/_integration.md
+	IntegrationListResourceMarkdownDescription string
+	//go:embed parts/list-resources/_policy.md
+	PolicyListResourceMarkdownDescription string
+	//go:embed parts/list-resources/_resource.md
+	ResourceListResourceMarkdownDescription string
+	//go:embed parts/list-resources/_role.md
+	RoleListResourceMarkdownDescription string
+	//go:embed parts/list-resources/_workflow.md
+	WorkflowListResourceMarkdownDescription string
+)
+
+// List of functions.
+var (
+	//go:embed parts/functions/_approval_step.md
+	ApprovalStepFunctionMarkdownDescription string
+	//go:embed parts/functions/_duration.md
+	DurationFunctionMarkdownDescription string
+	//go:embed parts/functions/_group.md
+	GroupFunctionMarkdownDescription string
+	//go:embed parts/functions/_user.md
+	UserFunctionMarkdownDescription string
+)
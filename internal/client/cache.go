@@ -0,0 +1,182 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// lookupCacheKey marks a context whose GET requests may be served from a
+// LookupCacheDoer.
+type lookupCacheKey struct{}
+
+// WithLookupCache returns a copy of ctx whose GET requests may be answered
+// from the lookup cache. It is meant for the list requests made to resolve a
+// name or external ID to an ID, which many resources of a plan repeat.
+func WithLookupCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lookupCacheKey{}, true)
+}
+
+// dependentCollections lists the collections whose content changes along with
+// a write to another collection.
+var dependentCollections = map[string][]string{
+	"integrations": {"resources", "roles"},
+	"resources":    {"roles"},
+}
+
+// cachedResponse is a successful response kept by a LookupCacheDoer.
+type cachedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// cacheEntry is a cached response, or a response being fetched.
+type cacheEntry struct {
+	collection string
+	done       chan struct{}
+	resp       *cachedResponse // nil when the fetch failed
+}
+
+// LookupCacheDoer wraps an HttpRequestDoer with an in-memory cache of the GET
+// requests made with a context from WithLookupCache, keyed by URL, so by
+// endpoint and filters. A write to a collection drops the cached responses of
+// that collection. The cache lives as long as the doer, which is one provider
+// process.
+type LookupCacheDoer struct {
+	wrapped HttpRequestDoer
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// generations counts the writes to each collection, so a response fetched
+	// while the collection was written to is not kept.
+	generations map[string]uint64
+}
+
+func NewLookupCacheDoer(wrapped HttpRequestDoer) *LookupCacheDoer {
+	return &LookupCacheDoer{
+		wrapped:     wrapped,
+		entries:     map[string]*cacheEntry{},
+		generations: map[string]uint64{},
+	}
+}
+
+// collectionOf returns the collection of an API path, such as "roles" for
+// /public/v1/roles/{id}.
+func collectionOf(path string) string {
+	if _, rest, ok := strings.Cut(path, "/public/v1/"); ok {
+		path = rest
+	}
+	collection, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+
+	return collection
+}
+
+// Do answers cacheable requests from the cache, and invalidates the cache on
+// writes.
+func (d *LookupCacheDoer) Do(req *http.Request) (*http.Response, error) {
+	collection := collectionOf(req.URL.Path)
+
+	if req.Method != http.MethodGet {
+		resp, err := d.wrapped.Do(req)
+		d.invalidate(req.Context(), collection)
+		return resp, err
+	}
+
+	if cache, _ := req.Context().Value(lookupCacheKey{}).(bool); !cache {
+		return d.wrapped.Do(req)
+	}
+
+	key := req.URL.String()
+	for {
+		d.mu.Lock()
+		entry, ok := d.entries[key]
+		if !ok {
+			break // with d.mu held
+		}
+		d.mu.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		if entry.resp != nil {
+			tflog.Debug(req.Context(), "entitle lookup cache: hit", map[string]any{"url": key})
+			return entry.resp.response(req), nil
+		}
+
+		// The fetch this request waited for failed and was dropped from the
+		// cache, so try again.
+	}
+
+	entry := &cacheEntry{collection: collection, done: make(chan struct{})}
+	d.entries[key] = entry
+	generation := d.generations[collection]
+	d.mu.Unlock()
+
+	resp, err := d.wrapped.Do(req)
+	if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		var body []byte
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err == nil {
+			entry.resp = &cachedResponse{status: resp.StatusCode, header: resp.Header.Clone(), body: body}
+			resp = entry.resp.response(req)
+		}
+	}
+
+	d.mu.Lock()
+	if (entry.resp == nil || d.generations[collection] != generation) && d.entries[key] == entry {
+		delete(d.entries, key)
+	}
+	d.mu.Unlock()
+	close(entry.done)
+
+	return resp, err
+}
+
+// invalidate drops the cached responses of collection and of the collections
+// that depend on it.
+func (d *LookupCacheDoer) invalidate(ctx context.Context, collection string) {
+	collections := append([]string{collection}, dependentCollections[collection]...)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, c := range collections {
+		d.generations[c]++
+	}
+
+	for key, entry := range d.entries {
+		for _, c := range collections {
+			if entry.collection == c {
+				delete(d.entries, key)
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "entitle lookup cache: invalidated", map[string]any{"collections": collections})
+}
+
+// response returns a copy of the cached response for req.
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.status, http.StatusText(c.status)),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// countingDoer answers every request with its URL and counts the requests by
+// method and path.
+type countingDoer struct {
+	mu     sync.Mutex
+	calls  map[string]int
+	status int
+}
+
+func (c *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.calls == nil {
+		c.calls = map[string]int{}
+	}
+	c.calls[req.Method+" "+req.URL.Path]++
+
+	status := c.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(req.URL.String())),
+	}, nil
+}
+
+func (c *countingDoer) count(key string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls[key]
+}
+
+func doBody(t *testing.T, d HttpRequestDoer, ctx context.Context, method, url string) string {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := d.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(body)
+}
+
+func TestCollectionOf(t *testing.T) {
+	for path, want := range map[string]string{
+		"/public/v1/roles":                 "roles",
+		"/public/v1/roles/1234":            "roles",
+		"/public/v1/permissions/1/revoke":  "permissions",
+		"/tenant/public/v1/integrations/1": "integrations",
+		"/workflows":                       "workflows",
+	} {
+		if got := collectionOf(path); got != want {
+			t.Errorf("collectionOf(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestLookupCacheDoer(t *testing.T) {
+	mock := &countingDoer{}
+	d := NewLookupCacheDoer(mock)
+	ctx := WithLookupCache(context.Background())
+
+	const roles = "https://api.example.com/public/v1/roles?page=1&resourceId=r1"
+	for range 3 {
+		if got := doBody(t, d, ctx, http.MethodGet, roles); got != roles {
+			t.Fatalf("got body %q, want %q", got, roles)
+		}
+	}
+	if n := mock.count("GET /public/v1/roles"); n != 1 {
+		t.Fatalf("got %d requests, want 1", n)
+	}
+
+	// Other filters are another entry.
+	doBody(t, d, ctx, http.MethodGet, "https://api.example.com/public/v1/roles?page=2&resourceId=r1")
+	if n := mock.count("GET /public/v1/roles"); n != 2 {
+		t.Fatalf("got %d requests, want 2", n)
+	}
+
+	// Requests without the context marker are not cached.
+	doBody(t, d, context.Background(), http.MethodGet, roles)
+	if n := mock.count("GET /public/v1/roles"); n != 3 {
+		t.Fatalf("got %d requests, want 3", n)
+	}
+
+	// A write to another collection keeps the entries.
+	doBody(t, d, ctx, http.MethodPost, "https://api.example.com/public/v1/workflows")
+	doBody(t, d, ctx, http.MethodGet, roles)
+	if n := mock.count("GET /public/v1/roles"); n != 3 {
+		t.Fatalf("got %d requests, want 3", n)
+	}
+
+	// A write to the collection, or to a collection it depends on, drops them.
+	for i, write := range []string{"/public/v1/roles/1234", "/public/v1/resources/r1"} {
+		doBody(t, d, ctx, http.MethodPatch, "https://api.example.com"+write)
+		doBody(t, d, ctx, http.MethodGet, roles)
+		if n, want := mock.count("GET /public/v1/roles"), 4+i; n != want {
+			t.Fatalf("after writing %s: got %d requests, want %d", write, n, want)
+		}
+	}
+}
+
+func TestLookupCacheDoer_errorsAreNotCached(t *testing.T) {
+	mock := &countingDoer{status: http.StatusInternalServerError}
+	d := NewLookupCacheDoer(mock)
+	ctx := WithLookupCache(context.Background())
+
+	const url = "https://api.example.com/public/v1/bundles"
+	doBody(t, d, ctx, http.MethodGet, url)
+	doBody(t, d, ctx, http.MethodGet, url)
+	if n := mock.count("GET /public/v1/bundles"); n != 2 {
+		t.Fatalf("got %d requests, want 2", n)
+	}
+}
+
+// blockingDoer holds every request until release is closed.
+type blockingDoer struct {
+	countingDoer
+	release chan struct{}
+}
+
+func (b *blockingDoer) Do(req *http.Request) (*http.Response, error) {
+	<-b.release
+	return b.countingDoer.Do(req)
+}
+
+func TestLookupCacheDoer_concurrentRequestsShareAFetch(t *testing.T) {
+	mock := &blockingDoer{release: make(chan struct{})}
+	d := NewLookupCacheDoer(mock)
+	ctx := WithLookupCache(context.Background())
+
+	const url = "https://api.example.com/public/v1/resources?integrationId=i1"
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			resp, err := d.Do(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer resp.Body.Close()
+
+			if body, _ := io.ReadAll(resp.Body); string(body) != url {
+				t.Errorf("got body %q, want %q", body, url)
+			}
+		})
+	}
+
+	close(mock.release)
+	wg.Wait()
+
+	if n := mock.count("GET /public/v1/resources"); n != 1 {
+		t.Fatalf("got %d requests, want 1", n)
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+// EnumAuditLogEventTypeValues lists every EnumAuditLogEventType accepted by AuditLogs_search.
+// The generator does not emit constants for this enum, so the values are kept here.
+var EnumAuditLogEventTypeValues = []EnumAuditLogEventType{
+	"accessReview.created",
+	"accessReview.deleted",
+	"accessReview.done",
+	"accessReview.updated",
+	"accessReview.activated",
+	"approvalAlgorithm.created",
+	"approvalAlgorithm.deleted",
+	"approvalAlgorithm.updated",
+	"bundle.created",
+	"bundle.deleted",
+	"bundle.updated",
+	"company.failed.sync.directories.groups",
+	"company.failed.sync.directories.users",
+	"company.failed.sync.hr.directManagers",
+	"company.policy.permissions.updated",
+	"company.policy.user.missingActors",
+	"company.sync.directories.groups",
+	"company.sync.directories.users",
+	"company.sync.hr.directManagers",
+	"integration.failed.access.give",
+	"integration.failed.access.revoke",
+	"integration.failed.sync.actors",
+	"integration.failed.sync.assets",
+	"integration.failed.sync.permissions",
+	"integration.sync.actors",
+	"integration.sync.assets",
+	"integration.sync.permissions",
+	"integration.updated.allowedDurations",
+	"integration.updated.allowsRequests",
+	"integration.updated.approvalAlgorithm",
+	"integration.updated.autoAssignRecommendedResourceMaintainers",
+	"integration.updated.autoAssignRecommendedResourceOwner",
+	"integration.updated.canCreateActors",
+	"integration.updated.canEditPermissions",
+	"integration.updated.configuration",
+	"integration.updated.defaultAllowsRequests",
+	"integration.updated.restoreImage",
+	"integration.updated.isVirtual",
+	"integration.updated.maintainers",
+	"integration.updated.name",
+	"integration.updated.notifyAboutExternalPermissions",
+	"integration.updated.owner",
+	"integration.updated.readonly",
+	"integration.created",
+	"integration.deleted",
+	"integrationResource.created.manually",
+	"integrationResource.deleted.manually",
+	"integrationResource.updated.allowedDurations",
+	"integrationResource.updated.allowsRequests",
+	"integrationResource.updated.approvalAlgorithm",
+	"integrationResource.updated.name",
+	"integrationResource.updated.maintainers",
+	"integrationResource.updated.owner",
+	"integrationResource.updated.userDefinedDescription",
+	"integrationResource.updated.userDefinedTags",
+	"integrationResourceRole.created.manually",
+	"integrationResourceRole.deleted.manually",
+	"integrationResourceRole.updated.allowedDurations",
+	"integrationResourceRole.updated.allowsRequests",
+	"integrationResourceRole.updated.approvalAlgorithm",
+	"integrationResourceRole.updated.name",
+	"policy.updated.data",
+	"policy.updated.sortOrder",
+	"policy.created",
+	"policy.deleted",
+	"ticket.failed.grant",
+	"ticket.failed.revoke",
+	"ticket.redirect.emptyGroup",
+	"ticket.redirect.forward",
+	"ticket.redirect.noManagers",
+	"ticket.redirect.noTeamMembers",
+	"ticket.redirect.toUser",
+	"ticket.retry.grant",
+	"ticket.retry.revoke",
+	"ticket.sent.grant",
+	"ticket.sent.revert",
+	"ticket.sent.revoke",
+	"ticket.automaticApproval",
+	"ticket.created",
+	"ticket.deleted",
+	"ticket.finalApproved",
+	"ticket.granted",
+	"ticket.passedStage",
+	"ticket.permissionAlreadyExist",
+	"ticket.permissionReverted",
+	"ticket.permissionWontRevoke",
+	"ticket.revoked",
+	"ticket.statusChanged",
+	"ticket.taskCreated",
+	"ticket.userApproved",
+	"ticket.userDeclined",
+}
+
+// EnumAllowedDurationsLabels lists every EnumAllowedDurations in ascending order
+// with its label, as accepted by the allowed_duration_labels attributes.
+var EnumAllowedDurationsLabels = []struct {
+	Value EnumAllowedDurations
+	Label string
+}{
+	{N1800, "30m"},
+	{N3600, "1h"},
+	{N10800, "3h"},
+	{N21600, "6h"},
+	{N43200, "12h"},
+	{N57600, "16h"},
+	{N86400, "1d"},
+	{N259200, "3d"},
+	{N604800, "7d"},
+	{N2628e06, "1mo"},
+	{N7884e06, "3mo"},
+	{N15768e07, "6mo"},
+	{N31536e07, "1y"},
+	{N63072e07, "2y"},
+	{Minus1, "permanent"},
+}
+
+// Label returns the label of d, and false when d is not one of the
+// EnumAllowedDurations values.
+func (d EnumAllowedDurations) Label() (string, bool) {
+	for _, l := range EnumAllowedDurationsLabels {
+		if l.Value == d {
+			return l.Label, true
+		}
+	}
+
+	return "", false
+}
+
+// EnumAllowedDurationsFromLabel returns the EnumAllowedDurations with the
+// given label, and false when there is none.
+func EnumAllowedDurationsFromLabel(label string) (EnumAllowedDurations, bool) {
+	for _, l := range EnumAllowedDurationsLabels {
+		if l.Label == label {
+			return l.Value, true
+		}
+	}
+
+	return 0, false
+}
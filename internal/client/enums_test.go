@@ -0,0 +1,27 @@
+package client
+
+import "testing"
+
+func TestEnumAllowedDurationsLabels(t *testing.T) {
+	labels := map[string]bool{}
+	for _, l := range EnumAllowedDurationsLabels {
+		if labels[l.Label] {
+			t.Errorf("label %q is used twice", l.Label)
+		}
+		labels[l.Label] = true
+
+		if got, ok := l.Value.Label(); !ok || got != l.Label {
+			t.Errorf("%v.Label() = %q, %v, want %q", l.Value, got, ok, l.Label)
+		}
+		if got, ok := EnumAllowedDurationsFromLabel(l.Label); !ok || got != l.Value {
+			t.Errorf("EnumAllowedDurationsFromLabel(%q) = %v, %v, want %v", l.Label, got, ok, l.Value)
+		}
+	}
+
+	if _, ok := EnumAllowedDurations(7200).Label(); ok {
+		t.Error("7200 should not have a label")
+	}
+	if _, ok := EnumAllowedDurationsFromLabel("2h"); ok {
+		t.Error(`"2h" should not be a label`)
+	}
+}
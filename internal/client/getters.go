@@ -50,3 +50,10 @@ func (i IntegrationResourceListItemResponseSchema) GetExternalID() string {
 
 	return *i.ExternalId
 }
+
+func (g DirectoryGroupResponseSchema) GetID() uuid.UUID {
+	return g.Id
+}
+func (g DirectoryGroupResponseSchema) GetName() string {
+	return g.Name
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultReadRequestsPerSecond and DefaultReadBurst are the read budget used
+	// unless overridden with WithReadLimit.
+	DefaultReadRequestsPerSecond = 10.0
+	DefaultReadBurst             = 20
+
+	// DefaultWriteRequestsPerSecond and DefaultWriteBurst are the write budget
+	// used unless overridden with WithWriteLimit.
+	DefaultWriteRequestsPerSecond = 5.0
+	DefaultWriteBurst             = 10
+
+	// maxRateLimitPause caps how long a Retry-After header may pause the
+	// limiter, so a bogus header cannot stall a whole apply.
+	maxRateLimitPause = DefaultMaxBackoff
+)
+
+// tokenBucket is a token-bucket rate limiter. Callers reserve a token and are
+// told how long to wait before using it, so waiting happens outside the lock.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64 // may go negative when tokens are reserved ahead of time
+	// last is the time tokens was last computed at. It is in the future while
+	// the bucket is paused by a Retry-After header.
+	last time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// advance refills the bucket for the time elapsed since last.
+func (b *tokenBucket) advance(now time.Time) {
+	if b.last.IsZero() {
+		b.last = now
+		return
+	}
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// sending its request.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(now)
+	b.tokens--
+
+	wait := b.last.Sub(now)
+	if b.tokens < 0 {
+		wait += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+
+	return wait
+}
+
+// pause stops handing out tokens for d and drops any saved-up burst, so the
+// requests queued behind the pause resume at the steady rate.
+func (b *tokenBucket) pause(now time.Time, d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(now)
+	if until := now.Add(d); until.After(b.last) {
+		b.last = until
+	}
+	b.tokens = math.Min(b.tokens, 0)
+}
+
+// RateLimitDoer wraps an HttpRequestDoer with client-side token-bucket rate
+// limiting. Reads (GET, HEAD, OPTIONS) and writes draw from separate buckets.
+// A Retry-After header on any response pauses both buckets, so concurrent
+// requests wait together instead of each running into the server limit.
+type RateLimitDoer struct {
+	wrapped HttpRequestDoer
+	read    *tokenBucket
+	write   *tokenBucket
+}
+
+// RateLimitOption configures a RateLimitDoer created by NewRateLimitDoer.
+type RateLimitOption func(*RateLimitDoer)
+
+// WithReadLimit sets the read budget. Non-positive values are ignored.
+func WithReadLimit(requestsPerSecond float64, burst int) RateLimitOption {
+	return func(d *RateLimitDoer) {
+		if requestsPerSecond > 0 && burst > 0 {
+			d.read = newTokenBucket(requestsPerSecond, burst)
+		}
+	}
+}
+
+// WithWriteLimit sets the write budget. Non-positive values are ignored.
+func WithWriteLimit(requestsPerSecond float64, burst int) RateLimitOption {
+	return func(d *RateLimitDoer) {
+		if requestsPerSecond > 0 && burst > 0 {
+			d.write = newTokenBucket(requestsPerSecond, burst)
+		}
+	}
+}
+
+func NewRateLimitDoer(wrapped HttpRequestDoer, opts ...RateLimitOption) *RateLimitDoer {
+	d := &RateLimitDoer{
+		wrapped: wrapped,
+		read:    newTokenBucket(DefaultReadRequestsPerSecond, DefaultReadBurst),
+		write:   newTokenBucket(DefaultWriteRequestsPerSecond, DefaultWriteBurst),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// bucketFor returns the bucket a request with the given method draws from.
+func (d *RateLimitDoer) bucketFor(method string) (*tokenBucket, string) {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return d.read, "read"
+	default:
+		return d.write, "write"
+	}
+}
+
+// Do waits for a token from the request's bucket, then sends the request.
+func (d *RateLimitDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	bucket, name := d.bucketFor(req.Method)
+
+	if wait := bucket.reserve(time.Now()); wait > 0 {
+		tflog.Debug(ctx, "entitle rate limit: waiting for a token",
+			map[string]any{"bucket": name, "method": req.Method, "url": req.URL.String(), "wait": wait.String()})
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := d.wrapped.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	d.adapt(ctx, resp)
+
+	return resp, nil
+}
+
+// adapt pauses both buckets for the duration requested by a Retry-After header.
+func (d *RateLimitDoer) adapt(ctx context.Context, resp *http.Response) {
+	pause, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+	if !ok || pause <= 0 {
+		return
+	}
+	pause = min(pause, maxRateLimitPause)
+
+	tflog.Debug(ctx, "entitle rate limit: Retry-After received, pausing requests",
+		map[string]any{"status": resp.StatusCode, "pause": pause.String()})
+
+	now := time.Now()
+	d.read.pause(now, pause)
+	d.write.pause(now, pause)
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_burstThenSteadyRate(t *testing.T) {
+	b := newTokenBucket(10, 2)
+	now := time.Unix(0, 0)
+
+	for i := 0; i < 2; i++ {
+		if wait := b.reserve(now); wait != 0 {
+			t.Fatalf("reserve %d: got wait %v, want 0", i, wait)
+		}
+	}
+
+	if wait := b.reserve(now); wait != 100*time.Millisecond {
+		t.Fatalf("got wait %v, want 100ms", wait)
+	}
+	if wait := b.reserve(now); wait != 200*time.Millisecond {
+		t.Fatalf("got wait %v, want 200ms", wait)
+	}
+}
+
+func TestTokenBucket_refillIsCappedAtBurst(t *testing.T) {
+	b := newTokenBucket(10, 2)
+	now := time.Unix(0, 0)
+	b.reserve(now)
+	b.reserve(now)
+
+	later := now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if wait := b.reserve(later); wait != 0 {
+			t.Fatalf("reserve %d: got wait %v, want 0", i, wait)
+		}
+	}
+	if wait := b.reserve(later); wait != 100*time.Millisecond {
+		t.Fatalf("got wait %v, want 100ms", wait)
+	}
+}
+
+func TestTokenBucket_pause(t *testing.T) {
+	b := newTokenBucket(10, 5)
+	now := time.Unix(0, 0)
+	b.reserve(now)
+
+	b.pause(now, 2*time.Second)
+
+	// The saved-up burst is dropped, so the first request after the pause
+	// waits for the pause plus one token.
+	if wait := b.reserve(now); wait != 2*time.Second+100*time.Millisecond {
+		t.Fatalf("got wait %v, want 2.1s", wait)
+	}
+
+	// A shorter pause does not shorten an ongoing one.
+	b.pause(now, time.Second)
+	if wait := b.reserve(now); wait != 2*time.Second+200*time.Millisecond {
+		t.Fatalf("got wait %v, want 2.2s", wait)
+	}
+}
+
+func TestNewRateLimitDoer_options(t *testing.T) {
+	d := NewRateLimitDoer(nil, WithReadLimit(3, 4), WithWriteLimit(1, 2))
+	if d.read.rate != 3 || d.read.burst != 4 || d.write.rate != 1 || d.write.burst != 2 {
+		t.Fatalf("unexpected buckets: read=%+v write=%+v", d.read, d.write)
+	}
+
+	d = NewRateLimitDoer(nil, WithReadLimit(0, 4), WithWriteLimit(1, 0))
+	if d.read.rate != DefaultReadRequestsPerSecond || d.write.burst != DefaultWriteBurst {
+		t.Fatalf("expected defaults, got read=%+v write=%+v", d.read, d.write)
+	}
+}
+
+func TestRateLimitDoer_separateReadAndWriteBudgets(t *testing.T) {
+	mock := &mockDoer{calls: []mockCall{
+		{resp: resp(http.StatusOK)},
+		{resp: resp(http.StatusOK)},
+	}}
+	d := NewRateLimitDoer(mock, WithReadLimit(0.1, 1), WithWriteLimit(0.1, 1))
+
+	// Each bucket has a single token, so one read and one write both go
+	// through without waiting.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	get, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/test", nil)
+	if _, err := d.Do(get); err != nil {
+		t.Fatal(err)
+	}
+	post, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.example.com/test", nil)
+	if _, err := d.Do(post); err != nil {
+		t.Fatal(err)
+	}
+	if mock.idx != 2 {
+		t.Fatalf("expected 2 calls, got %d", mock.idx)
+	}
+
+	// A second read has to wait ten seconds and gives up with the context.
+	get, _ = http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/test", nil)
+	if _, err := d.Do(get); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRateLimitDoer_retryAfterPausesBothBuckets(t *testing.T) {
+	limited := resp(http.StatusTooManyRequests)
+	limited.Header.Set("Retry-After", "30")
+	mock := &mockDoer{calls: []mockCall{{resp: limited}}}
+	d := NewRateLimitDoer(mock)
+
+	r, err := d.Do(getReq(t))
+	if err != nil || r.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err=%v status=%d", err, r.StatusCode)
+	}
+
+	now := time.Now()
+	for name, b := range map[string]*tokenBucket{"read": d.read, "write": d.write} {
+		if wait := b.reserve(now); wait < 29*time.Second {
+			t.Errorf("%s bucket: got wait %v, want at least 29s", name, wait)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"", 0, false},
+		{"10", 10 * time.Second, true},
+		{"0", 0, false},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.header)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = (%v, %v), want (%v, %v)", tt.header, got, ok, tt.want, tt.ok)
+		}
+	}
+}
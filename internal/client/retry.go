@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,13 +17,15 @@ const (
 	// DefaultRequestTimeout is the per-request HTTP timeout.
 	DefaultRequestTimeout = 30 * time.Second
 
-	defaultMaxAttempts = 5
-	defaultBaseBackoff = 2 * time.Second
-	defaultMaxBackoff  = 64 * time.Second
+	// DefaultMaxAttempts, DefaultBaseBackoff and DefaultMaxBackoff are used
+	// unless overridden with WithMaxAttempts, WithBaseBackoff and WithMaxBackoff.
+	DefaultMaxAttempts = 5
+	DefaultBaseBackoff = 2 * time.Second
+	DefaultMaxBackoff  = 64 * time.Second
 
-	// defaultRetryBudget caps the total wall-clock time for a Do call including
+	// DefaultRetryBudget caps the total wall-clock time for a Do call including
 	// all attempts and sleeps. http.Client.Timeout only bounds each attempt.
-	defaultRetryBudget = 3 * time.Minute
+	DefaultRetryBudget = 3 * time.Minute
 
 	// transportError is used as a status sentinel when no HTTP response was
 	// received (network/transport failure).
@@ -50,6 +54,13 @@ func isRetryable(method string, status int) bool {
 	return false
 }
 
+// isConnectionReset reports whether err is a connection reset or refused by
+// the peer. A refused connection never reached the server; a reset one may
+// have, which is why retrying these on non-idempotent methods is opt-in.
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
+
 // sleepWithContext waits for d, returning early with ctx.Err() if the context
 // is canceled. Uses time.NewTimer to avoid leaking the timer on early return.
 func sleepWithContext(ctx context.Context, d time.Duration) error {
@@ -78,21 +89,120 @@ func (b *cancelOnCloseBody) Close() error {
 
 // RetryDoer wraps an HttpRequestDoer with retry logic for 429 and 502
 // responses, honouring the Retry-After header and falling back to exponential
-// backoff. Transport errors are retried on idempotent methods.
+// backoff. Transport errors are retried on idempotent methods. Retrying 503
+// responses and connection resets on any method is opt-in.
 type RetryDoer struct {
 	wrapped     HttpRequestDoer
 	maxAttempts int
 	baseBackoff time.Duration
 	maxBackoff  time.Duration
+	budget      time.Duration
+
+	retryServiceUnavailable bool
+	retryConnectionReset    bool
+}
+
+// RetryOption configures a RetryDoer created by NewRetryDoer.
+type RetryOption func(*RetryDoer)
+
+// WithMaxAttempts sets the maximum number of attempts, including the first
+// one. A value of 1 disables retries. Values below 1 are ignored.
+func WithMaxAttempts(n int) RetryOption {
+	return func(r *RetryDoer) {
+		if n > 0 {
+			r.maxAttempts = n
+		}
+	}
+}
+
+// WithBaseBackoff sets the backoff before the first retry. It doubles on each
+// further retry up to the max backoff. Non-positive values are ignored.
+func WithBaseBackoff(d time.Duration) RetryOption {
+	return func(r *RetryDoer) {
+		if d > 0 {
+			r.baseBackoff = d
+		}
+	}
+}
+
+// WithMaxBackoff caps the backoff between attempts, including waits taken
+// from the Retry-After header. Non-positive values are ignored.
+func WithMaxBackoff(d time.Duration) RetryOption {
+	return func(r *RetryDoer) {
+		if d > 0 {
+			r.maxBackoff = d
+		}
+	}
+}
+
+// WithRetryBudget caps the total wall-clock time of a Do call across all
+// attempts and sleeps. Non-positive values are ignored.
+func WithRetryBudget(d time.Duration) RetryOption {
+	return func(r *RetryDoer) {
+		if d > 0 {
+			r.budget = d
+		}
+	}
+}
+
+// WithRetryOnServiceUnavailable makes 503 responses retryable on any method.
+// Entitle answers 503 during maintenance windows, before processing the request.
+func WithRetryOnServiceUnavailable(enabled bool) RetryOption {
+	return func(r *RetryDoer) {
+		r.retryServiceUnavailable = enabled
+	}
 }
 
-func NewRetryDoer(wrapped HttpRequestDoer) *RetryDoer {
-	return &RetryDoer{
+// WithRetryOnConnectionReset makes connection reset and refused errors
+// retryable on non-idempotent methods too. A reset request may already have
+// been processed by the server, so enabling this can duplicate writes.
+func WithRetryOnConnectionReset(enabled bool) RetryOption {
+	return func(r *RetryDoer) {
+		r.retryConnectionReset = enabled
+	}
+}
+
+func NewRetryDoer(wrapped HttpRequestDoer, opts ...RetryOption) *RetryDoer {
+	r := &RetryDoer{
 		wrapped:     wrapped,
-		maxAttempts: defaultMaxAttempts,
-		baseBackoff: defaultBaseBackoff,
-		maxBackoff:  defaultMaxBackoff,
+		maxAttempts: DefaultMaxAttempts,
+		baseBackoff: DefaultBaseBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+		budget:      DefaultRetryBudget,
+	}
+
+	for _, opt := range opts {
+		opt(r)
 	}
+
+	return r
+}
+
+// shouldRetry extends isRetryable with the opt-in retryable conditions.
+// err is the transport error when status is transportError.
+func (r *RetryDoer) shouldRetry(method string, status int, err error) bool {
+	if isRetryable(method, status) {
+		return true
+	}
+
+	switch status {
+	case http.StatusServiceUnavailable:
+		return r.retryServiceUnavailable
+	case transportError:
+		return r.retryConnectionReset && isConnectionReset(err)
+	}
+
+	return false
+}
+
+// retryBudget returns the configured budget, falling back to the default for
+// a RetryDoer that was not built with NewRetryDoer.
+func (r *RetryDoer) retryBudget() time.Duration {
+	if r.budget > 0 {
+		return r.budget
+	}
+
+	return DefaultRetryBudget
 }
 
 // Do executes the request with retry logic. It calls req.WithContext internally,
@@ -100,7 +210,7 @@ func NewRetryDoer(wrapped HttpRequestDoer) *RetryDoer {
 // original. Do is not safe to call twice with the same *http.Request; the
 // generated client always builds a fresh one, so this is fine in practice.
 func (r *RetryDoer) Do(req *http.Request) (*http.Response, error) {
-	ctx, cancel := context.WithTimeout(req.Context(), defaultRetryBudget)
+	ctx, cancel := context.WithTimeout(req.Context(), r.retryBudget())
 	// cancelOwned tracks whether this function still owns cancel. On success
 	// paths, ownership is transferred to the response body so the context
 	// stays alive while the caller reads it; the defer only fires on errors.
@@ -131,7 +241,7 @@ func (r *RetryDoer) Do(req *http.Request) (*http.Response, error) {
 
 		resp, err := r.wrapped.Do(req)
 		if err != nil {
-			if attempt < r.maxAttempts-1 && isRetryable(req.Method, transportError) {
+			if attempt < r.maxAttempts-1 && r.shouldRetry(req.Method, transportError, err) {
 				tflog.Debug(ctx, "entitle retry: transport error, will retry",
 					map[string]any{"attempt": attempt + 1, "method": req.Method, "error": err.Error(), "backoff": backoff.String()})
 				if sleepErr := sleepWithContext(ctx, backoff); sleepErr != nil {
@@ -143,7 +253,7 @@ func (r *RetryDoer) Do(req *http.Request) (*http.Response, error) {
 			return nil, err
 		}
 
-		if !isRetryable(req.Method, resp.StatusCode) {
+		if !r.shouldRetry(req.Method, resp.StatusCode, nil) {
 			return withCancel(resp), nil
 		}
 
@@ -179,23 +289,39 @@ func (r *RetryDoer) Do(req *http.Request) (*http.Response, error) {
 // absent or unparseable.
 func (r *RetryDoer) retryAfterDuration(ctx context.Context, resp *http.Response, backoff time.Duration) time.Duration {
 	ra := resp.Header.Get("Retry-After")
-	if ra == "" {
+
+	d, ok := parseRetryAfter(ra)
+	if !ok {
 		return backoff
 	}
 
+	if d > 0 {
+		return min(d, r.maxBackoff)
+	}
+
+	tflog.Debug(ctx, "entitle retry: Retry-After date is in the past, possible clock skew",
+		map[string]any{"retry_after": ra, "backoff": backoff.String()})
+
+	return backoff
+}
+
+// parseRetryAfter parses a Retry-After header value in its delta-seconds or
+// HTTP-date form. ok is false if the value is absent or unparseable; a date in
+// the past yields ok with a non-positive duration.
+func parseRetryAfter(ra string) (time.Duration, bool) {
+	if ra == "" {
+		return 0, false
+	}
+
 	// delta-seconds form
-	if secs, err := strconv.Atoi(ra); err == nil && secs > 0 {
-		return min(time.Duration(secs)*time.Second, r.maxBackoff)
+	if secs, err := strconv.Atoi(ra); err == nil {
+		return time.Duration(secs) * time.Second, secs > 0
 	}
 
 	// HTTP-date form
 	if t, err := http.ParseTime(ra); err == nil {
-		if d := time.Until(t); d > 0 {
-			return min(d, r.maxBackoff)
-		}
-		tflog.Debug(ctx, "entitle retry: Retry-After date is in the past, possible clock skew",
-			map[string]any{"retry_after": ra, "backoff": backoff.String()})
+		return time.Until(t), true
 	}
 
-	return backoff
+	return 0, false
 }
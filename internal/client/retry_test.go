@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
 	"net/http"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -65,7 +67,7 @@ func postReq(t *testing.T) *http.Request {
 func fastDoer(d HttpRequestDoer) *RetryDoer {
 	return &RetryDoer{
 		wrapped:     d,
-		maxAttempts: defaultMaxAttempts,
+		maxAttempts: DefaultMaxAttempts,
 		baseBackoff: time.Millisecond,
 		maxBackoff:  5 * time.Millisecond,
 	}
@@ -200,27 +202,130 @@ func TestDo_transportError_doesNotRetryPost(t *testing.T) {
 	}
 }
 
+func TestNewRetryDoer_options(t *testing.T) {
+	rd := NewRetryDoer(nil,
+		WithMaxAttempts(2),
+		WithBaseBackoff(time.Second),
+		WithMaxBackoff(10*time.Second),
+		WithRetryBudget(time.Minute),
+		WithRetryOnServiceUnavailable(true),
+		WithRetryOnConnectionReset(true),
+	)
+	if rd.maxAttempts != 2 || rd.baseBackoff != time.Second || rd.maxBackoff != 10*time.Second || rd.budget != time.Minute {
+		t.Fatalf("unexpected settings: %+v", rd)
+	}
+	if !rd.retryServiceUnavailable || !rd.retryConnectionReset {
+		t.Fatal("expected opt-in retries to be enabled")
+	}
+}
+
+func TestNewRetryDoer_ignoresInvalidOptions(t *testing.T) {
+	rd := NewRetryDoer(nil, WithMaxAttempts(0), WithBaseBackoff(-time.Second), WithMaxBackoff(0), WithRetryBudget(0))
+	if rd.maxAttempts != DefaultMaxAttempts || rd.baseBackoff != DefaultBaseBackoff ||
+		rd.maxBackoff != DefaultMaxBackoff || rd.budget != DefaultRetryBudget {
+		t.Fatalf("expected defaults, got: %+v", rd)
+	}
+}
+
+func TestDo_maxAttemptsOne_doesNotRetry(t *testing.T) {
+	mock := &mockDoer{calls: []mockCall{{resp: resp(http.StatusTooManyRequests)}}}
+	rd := fastDoer(mock)
+	WithMaxAttempts(1)(rd)
+	r, err := rd.Do(getReq(t))
+	if err != nil || r.StatusCode != http.StatusTooManyRequests || mock.idx != 1 {
+		t.Fatalf("err=%v status=%d calls=%d", err, r.StatusCode, mock.idx)
+	}
+}
+
+func TestDo_503_notRetriedByDefault(t *testing.T) {
+	mock := &mockDoer{calls: []mockCall{{resp: resp(http.StatusServiceUnavailable)}}}
+	r, err := fastDoer(mock).Do(getReq(t))
+	if err != nil || r.StatusCode != http.StatusServiceUnavailable || mock.idx != 1 {
+		t.Fatalf("err=%v status=%d calls=%d", err, r.StatusCode, mock.idx)
+	}
+}
+
+func TestDo_503_retriesWhenEnabled(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		t.Run(method, func(t *testing.T) {
+			mock := &mockDoer{calls: []mockCall{
+				{resp: resp(http.StatusServiceUnavailable)},
+				{resp: resp(http.StatusOK)},
+			}}
+			rd := fastDoer(mock)
+			WithRetryOnServiceUnavailable(true)(rd)
+			req, _ := http.NewRequestWithContext(context.Background(), method, "https://api.example.com/test", nil)
+			r, err := rd.Do(req)
+			if err != nil || r.StatusCode != http.StatusOK || mock.idx != 2 {
+				t.Fatalf("err=%v status=%d calls=%d", err, r.StatusCode, mock.idx)
+			}
+		})
+	}
+}
+
+func TestDo_connectionReset_retriesPostWhenEnabled(t *testing.T) {
+	mock := &mockDoer{calls: []mockCall{
+		{err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}},
+		{err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}},
+		{resp: resp(http.StatusOK)},
+	}}
+	rd := fastDoer(mock)
+	WithRetryOnConnectionReset(true)(rd)
+	r, err := rd.Do(postReq(t))
+	if err != nil || r.StatusCode != http.StatusOK || mock.idx != 3 {
+		t.Fatalf("err=%v calls=%d", err, mock.idx)
+	}
+}
+
+func TestDo_otherTransportError_doesNotRetryPostWhenResetEnabled(t *testing.T) {
+	netErr := errors.New("timeout")
+	mock := &mockDoer{calls: []mockCall{{err: netErr}}}
+	rd := fastDoer(mock)
+	WithRetryOnConnectionReset(true)(rd)
+	_, err := rd.Do(postReq(t))
+	if !errors.Is(err, netErr) || mock.idx != 1 {
+		t.Fatalf("err=%v calls=%d", err, mock.idx)
+	}
+}
+
+func TestDo_retryBudgetExceeded(t *testing.T) {
+	rd := &RetryDoer{
+		wrapped: doerFunc(func(_ *http.Request) (*http.Response, error) {
+			return resp(http.StatusTooManyRequests), nil
+		}),
+		maxAttempts: DefaultMaxAttempts,
+		baseBackoff: time.Hour,
+		maxBackoff:  time.Hour,
+		budget:      10 * time.Millisecond,
+	}
+
+	_, err := rd.Do(getReq(t))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+}
+
 func TestDo_exhaustedAttempts_returnsLastResponse(t *testing.T) {
-	calls := make([]mockCall, defaultMaxAttempts)
+	calls := make([]mockCall, DefaultMaxAttempts)
 	for i := range calls {
 		calls[i] = mockCall{resp: resp(http.StatusTooManyRequests)}
 	}
 	mock := &mockDoer{calls: calls}
 	r, err := fastDoer(mock).Do(getReq(t))
-	if err != nil || r.StatusCode != http.StatusTooManyRequests || mock.idx != defaultMaxAttempts {
+	if err != nil || r.StatusCode != http.StatusTooManyRequests || mock.idx != DefaultMaxAttempts {
 		t.Fatalf("err=%v status=%d calls=%d", err, r.StatusCode, mock.idx)
 	}
 }
 
 func TestDo_exhaustedTransportErrors_returnsError(t *testing.T) {
 	netErr := errors.New("timeout")
-	calls := make([]mockCall, defaultMaxAttempts)
+	calls := make([]mockCall, DefaultMaxAttempts)
 	for i := range calls {
 		calls[i] = mockCall{err: netErr}
 	}
 	mock := &mockDoer{calls: calls}
 	_, err := fastDoer(mock).Do(getReq(t))
-	if !errors.Is(err, netErr) || mock.idx != defaultMaxAttempts {
+	if !errors.Is(err, netErr) || mock.idx != DefaultMaxAttempts {
 		t.Fatalf("err=%v calls=%d", err, mock.idx)
 	}
 }
@@ -235,7 +340,7 @@ func TestDo_contextCancelledDuringSleep(t *testing.T) {
 			cancel() // cancel after the first attempt so the retry sleep fires Done
 			return resp(http.StatusTooManyRequests), nil
 		}),
-		maxAttempts: defaultMaxAttempts,
+		maxAttempts: DefaultMaxAttempts,
 		baseBackoff: time.Hour,
 		maxBackoff:  time.Hour,
 	}
@@ -321,7 +426,7 @@ func TestDo_cancelTransferredToBody(t *testing.T) {
 		wrapped: doerFunc(func(_ *http.Request) (*http.Response, error) {
 			return mockResp, nil
 		}),
-		maxAttempts: defaultMaxAttempts,
+		maxAttempts: DefaultMaxAttempts,
 		baseBackoff: time.Millisecond,
 		maxBackoff:  5 * time.Millisecond,
 	}
@@ -0,0 +1,387 @@
+package fakeentitle
+
+// render turns a stored object into the response schema the API returns for
+// it. References to other objects are expanded from their current state.
+func (s *Server) render(name string, rec *record) map[string]any {
+	d := rec.data
+
+	switch name {
+	case Integrations:
+		return map[string]any{
+			"id":                                   rec.id,
+			"name":                                 str(d["name"]),
+			"application":                          map[string]any{"name": str(field(d, "application", "name"))},
+			"allowedDurations":                     list(d["allowedDurations"]),
+			"workflow":                             s.workflowRef(d["workflow"]),
+			"maintainers":                          s.maintainers(d["maintainers"]),
+			"prerequisitePermissions":              s.prerequisites(d["prerequisitePermissions"], true),
+			"readonly":                             boolean(d["readonly"]),
+			"allowChangingAccountPermissions":      boolean(d["allowChangingAccountPermissions"]),
+			"allowCreatingAccounts":                boolean(d["allowCreatingAccounts"]),
+			"requestable":                          boolean(first(d["requestable"], d["allowRequests"])),
+			"requestableByDefault":                 boolean(first(d["requestableByDefault"], d["allowRequestsByDefault"])),
+			"autoAssignRecommendedOwners":          boolean(d["autoAssignRecommendedOwners"]),
+			"autoAssignRecommendedMaintainers":     boolean(d["autoAssignRecommendedMaintainers"]),
+			"notifyAboutExternalPermissionChanges": boolean(d["notifyAboutExternalPermissionChanges"]),
+			"owner":                                s.userRef(d["owner"]),
+		}
+
+	case Resources:
+		return map[string]any{
+			"id":                      rec.id,
+			"externalId":              str(first(d["externalId"], rec.id)),
+			"name":                    str(d["name"]),
+			"integration":             s.integrationRef(field(d, "integration", "id")),
+			"allowedDurations":        list(d["allowedDurations"]),
+			"tags":                    list(d["userDefinedTags"]),
+			"userDefinedTags":         list(d["userDefinedTags"]),
+			"description":             str(d["userDefinedDescription"]),
+			"userDefinedDescription":  str(d["userDefinedDescription"]),
+			"workflow":                s.workflowRef(d["workflow"]),
+			"maintainers":             s.maintainers(d["maintainers"]),
+			"prerequisitePermissions": s.prerequisites(d["prerequisitePermissions"], true),
+			"requestable":             boolean(d["requestable"]),
+			"owner":                   s.userRef(d["owner"]),
+		}
+
+	case Roles:
+		role := s.roleRef(rec.id)
+		role["allowedDurations"] = list(d["allowedDurations"])
+		role["workflow"] = s.workflowRef(d["workflow"])
+		role["prerequisitePermissions"] = s.prerequisites(d["prerequisitePermissions"], false)
+		if id, ok := field(d, "virtualizedRole", "id").(string); ok {
+			role["virtualizedRole"] = s.roleRef(id)
+		}
+
+		return role
+
+	case Bundles:
+		var roles []any
+		for _, ref := range list(d["roles"]) {
+			roles = append(roles, s.roleRef(str(field(ref, "id"))))
+		}
+
+		return map[string]any{
+			"id":               rec.id,
+			"name":             str(d["name"]),
+			"description":      str(d["description"]),
+			"category":         str(d["category"]),
+			"tags":             list(d["tags"]),
+			"allowedDurations": list(d["allowedDurations"]),
+			"roles":            list(roles),
+			"workflow":         s.workflowRef(d["workflow"]),
+		}
+
+	case Workflows:
+		var rules []any
+		for _, rule := range list(d["rules"]) {
+			rules = append(rules, s.workflowRule(rule))
+		}
+
+		return map[string]any{
+			"id":    rec.id,
+			"name":  str(d["name"]),
+			"rules": list(rules),
+		}
+
+	case Policies:
+		var inGroups, bundles, roles []any
+		for _, ref := range list(d["inGroups"]) {
+			id := str(field(ref, "id"))
+			inGroups = append(inGroups, map[string]any{
+				"type": field(ref, "type"),
+				"id":   id,
+				"name": s.group(id).Name,
+			})
+		}
+		for _, ref := range list(d["bundles"]) {
+			id := str(field(ref, "id"))
+			bundles = append(bundles, map[string]any{"id": id, "name": s.name(Bundles, id)})
+		}
+		for _, ref := range list(d["roles"]) {
+			roles = append(roles, s.roleRef(str(field(ref, "id"))))
+		}
+
+		return map[string]any{
+			"id":        rec.id,
+			"inGroups":  list(inGroups),
+			"bundles":   list(bundles),
+			"roles":     list(roles),
+			"number":    d["number"],
+			"sortOrder": d["sortOrder"],
+		}
+
+	case AccessRequestForwards, AccessReviewForwards:
+		return map[string]any{
+			"id":        rec.id,
+			"forwarder": s.userRef(d["forwarder"]),
+			"target":    s.userRef(d["target"]),
+		}
+
+	case AgentTokens:
+		return map[string]any{
+			"id":   rec.id,
+			"name": str(d["name"]),
+		}
+
+	case Permissions:
+		role := s.roleRef(str(d["roleId"]))
+		user := s.user(str(d["accountId"]))
+
+		return map[string]any{
+			"permissionId": rec.id,
+			"createdAt":    d["createdAt"],
+			"account": map[string]any{
+				"id":          user.ID,
+				"name":        user.Email,
+				"euid":        user.Email,
+				"email":       user.Email,
+				"createdAt":   d["createdAt"],
+				"integration": field(role, "resource", "integration"),
+			},
+			"role":  role,
+			"types": d["types"],
+			"path":  d["path"],
+		}
+	}
+
+	return nil
+}
+
+// workflowRule renders a workflow rule, expanding group, schedule and user
+// references.
+func (s *Server) workflowRule(rule any) map[string]any {
+	var inGroups, inSchedules, steps []any
+	for _, ref := range list(field(rule, "inGroups")) {
+		inGroups = append(inGroups, s.groupRef(ref))
+	}
+	for _, ref := range list(field(rule, "inSchedules")) {
+		inSchedules = append(inSchedules, s.groupRef(ref))
+	}
+
+	for _, step := range list(field(rule, "approvalFlow", "steps")) {
+		var approval, notified []any
+		for _, e := range list(field(step, "approvalEntities")) {
+			approval = append(approval, s.approvalEntity(e))
+		}
+		for _, e := range list(field(step, "notifiedEntities")) {
+			notified = append(notified, s.approvalEntity(e))
+		}
+
+		steps = append(steps, map[string]any{
+			"sortOrder":        field(step, "sortOrder"),
+			"operator":         field(step, "operator"),
+			"approvalEntities": list(approval),
+			"notifiedEntities": list(notified),
+		})
+	}
+
+	return map[string]any{
+		"sortOrder":     field(rule, "sortOrder"),
+		"underDuration": field(rule, "underDuration"),
+		"anySchedule":   boolean(field(rule, "anySchedule")),
+		"inGroups":      list(inGroups),
+		"inSchedules":   list(inSchedules),
+		"approvalFlow":  map[string]any{"steps": list(steps)},
+	}
+}
+
+func (s *Server) approvalEntity(e any) map[string]any {
+	entity := field(e, "entity")
+
+	switch field(e, "type") {
+	case "User":
+		entity = s.userRef(entity)
+	case "DirectoryGroup", "OnCallIntegrationSchedule":
+		entity = s.groupRef(entity)
+	case "SlackChannel", "TeamsChannel":
+		entity = map[string]any{"id": field(entity, "id")}
+	default:
+		entity = nil
+	}
+
+	return map[string]any{"type": field(e, "type"), "entity": entity}
+}
+
+func (s *Server) maintainers(v any) []any {
+	out := []any{}
+	for _, m := range list(v) {
+		switch field(m, "type") {
+		case "user":
+			out = append(out, map[string]any{"type": "user", "user": s.userRef(field(m, "user"))})
+		case "group":
+			id := str(field(m, "group", "id"))
+			g := s.group(id)
+			out = append(out, map[string]any{"type": "group", "group": map[string]any{"id": id, "email": g.Email}})
+		}
+	}
+
+	return out
+}
+
+// prerequisites renders prerequisite permissions. Integrations and resources
+// return them nested in a list per prerequisite, roles return a flat list.
+func (s *Server) prerequisites(v any, nested bool) []any {
+	out := []any{}
+	for _, group := range list(v) {
+		var rendered []any
+		items, ok := group.([]any)
+		if !ok {
+			items = []any{group}
+		}
+		for _, p := range items {
+			rendered = append(rendered, map[string]any{
+				"default": boolean(field(p, "default")),
+				"role":    s.roleRef(str(field(p, "role", "id"))),
+			})
+		}
+
+		if nested {
+			out = append(out, list(rendered))
+		} else {
+			out = append(out, rendered...)
+		}
+	}
+
+	return out
+}
+
+func (s *Server) integrationRef(id any) map[string]any {
+	ref := map[string]any{"id": id, "name": "", "application": map[string]any{"name": ""}}
+	if rec, ok := s.collections[Integrations].records[str(id)]; ok {
+		ref["name"] = str(rec.data["name"])
+		ref["application"] = map[string]any{"name": str(field(rec.data, "application", "name"))}
+	}
+
+	return ref
+}
+
+func (s *Server) resourceRef(id string) map[string]any {
+	ref := map[string]any{"id": id, "name": "", "externalId": id, "integration": s.integrationRef("")}
+	if rec, ok := s.collections[Resources].records[id]; ok {
+		ref["name"] = str(rec.data["name"])
+		ref["externalId"] = str(first(rec.data["externalId"], id))
+		ref["integration"] = s.integrationRef(field(rec.data, "integration", "id"))
+	}
+
+	return ref
+}
+
+func (s *Server) roleRef(id string) map[string]any {
+	ref := map[string]any{"id": id, "name": "", "externalId": id, "requestable": false, "resource": s.resourceRef("")}
+	if rec, ok := s.collections[Roles].records[id]; ok {
+		ref["name"] = str(rec.data["name"])
+		ref["externalId"] = str(first(rec.data["externalId"], id))
+		ref["requestable"] = boolean(rec.data["requestable"])
+		ref["resource"] = s.resourceRef(str(field(rec.data, "resource", "id")))
+		if wf := rec.data["workflow"]; wf != nil {
+			ref["workflow"] = s.workflowRef(wf)
+		}
+	}
+
+	return ref
+}
+
+func (s *Server) workflowRef(v any) any {
+	id, ok := field(v, "id").(string)
+	if !ok || id == "" {
+		return nil
+	}
+
+	return map[string]any{"id": id, "name": s.name(Workflows, id)}
+}
+
+func (s *Server) userRef(v any) any {
+	id, ok := field(v, "id").(string)
+	if !ok || id == "" {
+		return nil
+	}
+
+	u := s.user(id)
+
+	return map[string]any{"id": u.ID, "email": u.Email}
+}
+
+func (s *Server) groupRef(v any) map[string]any {
+	g := s.group(str(field(v, "id")))
+
+	return map[string]any{"id": g.ID, "name": g.Name}
+}
+
+// user returns the registered user with the given ID, or one with a
+// generated email so the response still passes email validation.
+func (s *Server) user(id string) User {
+	if u, ok := s.users[id]; ok {
+		return u
+	}
+
+	return User{ID: id, Email: "user-" + shortID(id) + "@example.com"}
+}
+
+func (s *Server) group(id string) Group {
+	if g, ok := s.groups[id]; ok {
+		return g
+	}
+
+	return Group{ID: id, Name: "group-" + shortID(id), Email: "group-" + shortID(id) + "@example.com"}
+}
+
+func (s *Server) name(collection, id string) string {
+	if rec, ok := s.collections[collection].records[id]; ok {
+		return str(rec.data["name"])
+	}
+
+	return ""
+}
+
+// field walks nested JSON objects along keys and returns the value found, or
+// nil when any step is missing.
+func field(v any, keys ...string) any {
+	for _, k := range keys {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+
+	return v
+}
+
+// first returns the first non-nil value.
+func first(values ...any) any {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func str(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+func boolean(v any) bool {
+	b, _ := v.(bool)
+	return b
+}
+
+// list returns v as a JSON array, with nil rendered as an empty array.
+func list(v any) []any {
+	if l, ok := v.([]any); ok && l != nil {
+		return l
+	}
+
+	return []any{}
+}
+
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+
+	return id
+}
@@ -0,0 +1,651 @@
+// Package fakeentitle is an in-memory fake of the Entitle public API. It
+// serves the CRUD endpoints the provider's resources use, so resource tests
+// can run with resource.UnitTest without a live tenant.
+package fakeentitle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// APIKey is the API key the provider configuration returned by
+	// ProviderConfig authenticates with. Any non-empty bearer token is accepted.
+	APIKey = "fake-entitle-api-key"
+
+	basePath = "/public/v1"
+
+	defaultPerPage = 25
+	maxPerPage     = 100
+)
+
+// Collection names, as they appear in the API paths.
+const (
+	Integrations          = "integrations"
+	Resources             = "resources"
+	Roles                 = "roles"
+	Bundles               = "bundles"
+	Workflows             = "workflows"
+	Policies              = "policies"
+	AccessRequestForwards = "accessRequestForwards"
+	AccessReviewForwards  = "accessReviewForwards"
+	AgentTokens           = "agentTokens"
+	Permissions           = "permissions"
+
+	// Users and DirectoryGroups are read-only: they are seeded with AddUser
+	// and AddGroup and can only be listed.
+	Users           = "users"
+	DirectoryGroups = "directoryGroups"
+)
+
+// object is a stored API object: the create body merged with every update
+// body since. It is rendered into a response schema on each read, so
+// references to other objects always reflect their current names.
+type object = map[string]any
+
+type record struct {
+	id   string
+	seq  int
+	data object
+}
+
+type collection struct {
+	records map[string]*record
+}
+
+func (c *collection) sorted() []*record {
+	out := make([]*record, 0, len(c.records))
+	for _, r := range c.records {
+		out = append(out, r)
+	}
+	slices.SortFunc(out, func(a, b *record) int { return a.seq - b.seq })
+
+	return out
+}
+
+// Fault makes matching requests fail before they reach the fake API.
+type Fault struct {
+	// Method matches the request method. Empty matches any method.
+	Method string
+	// Path matches requests whose path, relative to /public/v1, starts with
+	// it, e.g. "/workflows". Empty matches any path.
+	Path string
+	// Status is the HTTP status to answer with, e.g. 429 or 502.
+	Status int
+	// RetryAfter, when positive, is sent as a Retry-After header in seconds.
+	RetryAfter time.Duration
+	// Count is how many matching requests fail. Zero means one.
+	Count int
+}
+
+func (f *Fault) matches(r *http.Request, path string) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.HasPrefix(path, f.Path)
+}
+
+// Request is a request received by the fake, recorded for assertions.
+type Request struct {
+	Method string
+	// Path is relative to /public/v1.
+	Path string
+}
+
+// User is a directory user known to the fake.
+type User struct {
+	ID    string
+	Email string
+}
+
+// Group is a directory group known to the fake.
+type Group struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+// Server is an in-memory fake Entitle API served over HTTP.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	seq         int
+	collections map[string]*collection
+	users       map[string]User
+	groups      map[string]Group
+	faults      []*Fault
+	requests    []Request
+}
+
+// NewServer starts a fake Entitle API on a loopback address. Close it when
+// the test is done, e.g. with t.Cleanup(srv.Close).
+func NewServer() *Server {
+	s := &Server{
+		collections: map[string]*collection{},
+		users:       map[string]User{},
+		groups:      map[string]Group{},
+	}
+
+	for _, name := range []string{
+		Integrations, Resources, Roles, Bundles, Workflows, Policies,
+		AccessRequestForwards, AccessReviewForwards, AgentTokens, Permissions,
+	} {
+		s.collections[name] = &collection{records: map[string]*record{}}
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// ProviderConfig returns a provider block that points the entitle provider
+// at the fake.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "entitle" {
+  endpoint              = %q
+  allow_custom_endpoint = true
+  api_key               = %q
+}
+`, s.URL, APIKey)
+}
+
+// InjectFault queues a fault. Faults are matched in the order they were
+// injected, and each is dropped once it has failed Count requests.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.Count <= 0 {
+		f.Count = 1
+	}
+	s.faults = append(s.faults, &f)
+}
+
+// Requests returns the requests received so far, including failed ones.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// AddUser registers a directory user, so references to it render with its
+// email. Users that were never added render with a generated email.
+func (s *Server) AddUser(email string) User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := User{ID: uuid.NewString(), Email: email}
+	s.users[u.ID] = u
+
+	return u
+}
+
+// AddGroup registers a directory group, so references to it render with its
+// name and email.
+func (s *Server) AddGroup(name, email string) Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g := Group{ID: uuid.NewString(), Name: name, Email: email}
+	s.groups[g.ID] = g
+
+	return g
+}
+
+// AddPermission grants an account a role and returns the permission ID.
+// Permissions cannot be created through the API, so tests seed them here.
+func (s *Server) AddPermission(accountID, roleID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.insert(Permissions, object{
+		"accountId": accountID,
+		"roleId":    roleID,
+		"types":     []any{"jit"},
+		"path":      "direct",
+		"createdAt": time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Add stores an object as it is sent in a create body, without the defaults
+// the API applies on create, and returns its ID. Synced resources and roles
+// cannot be created through the API, so tests seed them here.
+func (s *Server) Add(collection string, data map[string]any) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.insert(collection, data)
+}
+
+// Count returns how many objects a collection holds.
+func (s *Server) Count(collection string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.collections[collection].records)
+}
+
+// Get returns the rendered API object with the given ID, as the API would
+// return it in the result field, or false when it does not exist.
+func (s *Server) Get(collection, id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.collections[collection].records[id]
+	if !ok {
+		return nil, false
+	}
+
+	return s.render(collection, rec), true
+}
+
+func (s *Server) insert(collection string, data object) string {
+	s.seq++
+	rec := &record{id: uuid.NewString(), seq: s.seq, data: data}
+	s.collections[collection].records[rec.id] = rec
+
+	return rec.id
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path, ok := strings.CutPrefix(r.URL.Path, basePath)
+	if !ok {
+		writeError(w, http.StatusNotFound, "route.notFound", fmt.Sprintf("route %s %s not found", r.Method, r.URL.Path))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: path})
+
+	if s.fault(w, r, path) {
+		return
+	}
+
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); !ok || token == "" {
+		writeError(w, http.StatusUnauthorized, "", "missing bearer token")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	name := parts[0]
+	if (name == Users || name == DirectoryGroups) && len(parts) == 1 && r.Method == http.MethodGet {
+		s.listDirectory(w, r, name)
+		return
+	}
+	if _, ok := s.collections[name]; !ok {
+		writeError(w, http.StatusNotFound, "route.notFound", fmt.Sprintf("route %s %s not found", r.Method, r.URL.Path))
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, name)
+	case len(parts) == 1 && r.Method == http.MethodPost && name != Permissions:
+		s.create(w, r, name)
+	case len(parts) == 2 && r.Method == http.MethodGet && name == Permissions:
+		s.listAccountPermissions(w, r, parts[1])
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		s.read(w, name, parts[1])
+	case len(parts) == 2 && r.Method == http.MethodPut && name != Permissions && !isForwards(name):
+		s.update(w, r, name, parts[1])
+	case len(parts) == 2 && r.Method == http.MethodDelete && name != Permissions:
+		s.delete(w, name, parts[1])
+	case len(parts) == 3 && r.Method == http.MethodDelete && name == Permissions && parts[2] == "revoke":
+		s.revoke(w, parts[1])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "route.notFound", fmt.Sprintf("route %s %s not found", r.Method, r.URL.Path))
+	}
+}
+
+// fault answers the request with the first matching injected fault, if any.
+func (s *Server) fault(w http.ResponseWriter, r *http.Request, path string) bool {
+	for i, f := range s.faults {
+		if !f.matches(r, path) {
+			continue
+		}
+
+		f.Count--
+		if f.Count == 0 {
+			s.faults = slices.Delete(s.faults, i, i+1)
+		}
+
+		if f.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(f.RetryAfter.Seconds()))))
+		}
+		writeError(w, f.Status, "fault.injected", http.StatusText(f.Status))
+
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, name string) {
+	query := r.URL.Query()
+
+	var items []any
+	for _, rec := range s.collections[name].sorted() {
+		item := s.render(name, rec)
+		if !matchesQuery(item, query) {
+			continue
+		}
+
+		// Agent token list items wrap each token in its own result field.
+		if name == AgentTokens {
+			items = append(items, map[string]any{"result": item})
+			continue
+		}
+		items = append(items, item)
+	}
+
+	writeJSON(w, paginate(items, query))
+}
+
+// listDirectory lists the seeded users or directory groups whose email or
+// name contains the search query parameter, sorted by email.
+func (s *Server) listDirectory(w http.ResponseWriter, r *http.Request, name string) {
+	query := r.URL.Query()
+	search := strings.ToLower(query.Get("search"))
+
+	var items []map[string]any
+	if name == Users {
+		for _, u := range s.users {
+			items = append(items, map[string]any{
+				"id":         u.ID,
+				"email":      u.Email,
+				"givenName":  "",
+				"familyName": "",
+				"createdAt":  time.Unix(0, 0).UTC().Format(time.RFC3339),
+			})
+		}
+	} else {
+		for _, g := range s.groups {
+			items = append(items, map[string]any{"id": g.ID, "name": g.Name, "email": g.Email, "origin": "fake"})
+		}
+	}
+
+	slices.SortFunc(items, func(a, b map[string]any) int {
+		return strings.Compare(str(a["email"])+str(a["id"]), str(b["email"])+str(b["id"]))
+	})
+
+	var matches []any
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(str(item["email"])), search) ||
+			strings.Contains(strings.ToLower(str(item["name"])), search) {
+			matches = append(matches, item)
+		}
+	}
+
+	writeJSON(w, paginate(matches, query))
+}
+
+func (s *Server) listAccountPermissions(w http.ResponseWriter, r *http.Request, accountID string) {
+	query := r.URL.Query()
+
+	var items []any
+	for _, rec := range s.collections[Permissions].sorted() {
+		if rec.data["accountId"] != accountID {
+			continue
+		}
+		if item := s.render(Permissions, rec); matchesQuery(item, query) {
+			items = append(items, item)
+		}
+	}
+
+	writeJSON(w, paginate(items, query))
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, name string) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	if name == Resources {
+		s.inheritFrom(body, Integrations, "integration", "workflow", "owner", "allowedDurations", "maintainers")
+	}
+	if name == Roles {
+		s.inheritFrom(body, Resources, "resource", "workflow", "allowedDurations")
+	}
+	if name == Policies {
+		body["number"] = float64(s.seq + 1)
+		if _, ok := body["sortOrder"]; !ok {
+			body["sortOrder"] = float64(len(s.collections[Policies].records))
+		}
+	}
+
+	id := s.insert(name, body)
+	rec := s.collections[name].records[id]
+
+	// Creating a resource with inline roles creates the roles too.
+	if name == Resources {
+		roles, _ := body["roles"].([]any)
+		for _, role := range roles {
+			role, _ := role.(map[string]any)
+			s.insert(Roles, object{
+				"name":             role["name"],
+				"resource":         map[string]any{"id": id},
+				"requestable":      body["requestable"],
+				"workflow":         rec.data["workflow"],
+				"allowedDurations": rec.data["allowedDurations"],
+			})
+		}
+		delete(rec.data, "roles")
+	}
+
+	result := s.render(name, rec)
+	if name == AgentTokens {
+		result["token"] = "fake-agent-token-" + id
+	}
+
+	writeJSON(w, map[string]any{"result": result})
+}
+
+func (s *Server) read(w http.ResponseWriter, name, id string) {
+	rec, ok := s.collections[name].records[id]
+	if !ok {
+		writeNotFound(w, name, id)
+		return
+	}
+
+	writeJSON(w, map[string]any{"result": s.render(name, rec)})
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, name, id string) {
+	rec, ok := s.collections[name].records[id]
+	if !ok {
+		writeNotFound(w, name, id)
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	for k, v := range body {
+		rec.data[k] = v
+	}
+
+	writeJSON(w, map[string]any{"result": s.render(name, rec)})
+}
+
+func (s *Server) delete(w http.ResponseWriter, name, id string) {
+	if _, ok := s.collections[name].records[id]; !ok {
+		writeNotFound(w, name, id)
+		return
+	}
+
+	delete(s.collections[name].records, id)
+
+	writeJSON(w, map[string]any{"ok": true})
+}
+
+func (s *Server) revoke(w http.ResponseWriter, id string) {
+	rec, ok := s.collections[Permissions].records[id]
+	if !ok {
+		writeNotFound(w, Permissions, id)
+		return
+	}
+
+	result := s.render(Permissions, rec)
+	result["message"] = "permission revoked"
+	delete(s.collections[Permissions].records, id)
+
+	writeJSON(w, map[string]any{"result": result})
+}
+
+// inheritFrom fills the fields missing from body with the values of the
+// parent object body[parentField] refers to, the way the API defaults a
+// resource's settings to its integration's.
+func (s *Server) inheritFrom(body object, parents, parentField string, fields ...string) {
+	ref, _ := body[parentField].(map[string]any)
+	parentID, _ := ref["id"].(string)
+
+	parent, ok := s.collections[parents].records[parentID]
+	if !ok {
+		return
+	}
+
+	for _, f := range fields {
+		if v, ok := body[f]; !ok || v == nil {
+			body[f] = parent.data[f]
+		}
+	}
+}
+
+// matchesQuery applies the search, externalId, integrationId, resourceId and
+// roleId list filters to a rendered object.
+func matchesQuery(item map[string]any, query map[string][]string) bool {
+	get := func(key string) string {
+		if v := query[key]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	if search := strings.ToLower(get("search")); search != "" {
+		name, _ := item["name"].(string)
+		if !strings.Contains(strings.ToLower(name), search) {
+			return false
+		}
+	}
+
+	if externalID := get("externalId"); externalID != "" && item["externalId"] != externalID {
+		return false
+	}
+
+	role, _ := item["role"].(map[string]any)
+	if roleID := get("roleId"); roleID != "" && role["id"] != roleID {
+		return false
+	}
+	if role != nil {
+		item = role
+	}
+
+	resource, _ := item["resource"].(map[string]any)
+	if resourceID := get("resourceId"); resourceID != "" && resource["id"] != resourceID {
+		return false
+	}
+	if resource != nil {
+		item = resource
+	}
+
+	integration, _ := item["integration"].(map[string]any)
+	if integrationID := get("integrationId"); integrationID != "" && integration["id"] != integrationID {
+		return false
+	}
+
+	return true
+}
+
+// paginate slices items into the page requested with the page and perPage
+// query parameters and wraps it in the API's pagination envelope.
+func paginate(items []any, query map[string][]string) map[string]any {
+	page := queryInt(query, "page", 1)
+	perPage := min(queryInt(query, "perPage", defaultPerPage), maxPerPage)
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	return map[string]any{
+		"result": append([]any{}, items[start:end]...),
+		"pagination": map[string]any{
+			"page":         page,
+			"perPage":      perPage,
+			"totalResults": len(items),
+			"totalPages":   (len(items) + perPage - 1) / perPage,
+		},
+	}
+}
+
+func queryInt(query map[string][]string, key string, def int) int {
+	v := query[key]
+	if len(v) == 0 {
+		return def
+	}
+
+	// The generated client sends some page parameters as floats.
+	n, err := strconv.ParseFloat(v[0], 64)
+	if err != nil || n < 1 {
+		return def
+	}
+
+	return int(n)
+}
+
+func isForwards(name string) bool {
+	return name == AccessRequestForwards || name == AccessReviewForwards
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) (object, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "request.invalid", err.Error())
+		return nil, false
+	}
+
+	body := object{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "request.invalid", fmt.Sprintf("invalid JSON body: %s", err))
+		return nil, false
+	}
+
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError answers with the API's error body, leaving out errorId when
+// errorID is empty.
+func writeError(w http.ResponseWriter, status int, errorID, message string) {
+	body := map[string]any{"message": message}
+	if errorID != "" {
+		body["errorId"] = errorID
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeNotFound(w http.ResponseWriter, name, id string) {
+	writeError(w, http.StatusNotFound, "resource.notFound", fmt.Sprintf("%s %s not found", name, id))
+}
@@ -0,0 +1,262 @@
+package fakeentitle_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+func newClient(t *testing.T, srv *fakeentitle.Server, doer client.HttpRequestDoer) *client.ClientWithResponses {
+	t.Helper()
+
+	if doer == nil {
+		doer = srv.Client()
+	}
+
+	c, err := client.NewClientWithResponses(srv.URL,
+		client.WithHTTPClient(doer),
+		client.WithRequestEditorFn(client.SetBearerToken(fakeentitle.APIKey)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func newServer(t *testing.T) *fakeentitle.Server {
+	t.Helper()
+
+	srv := fakeentitle.NewServer()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestServer_agentTokenCRUD(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	c := newClient(t, srv, nil)
+
+	created, err := c.AgentTokensCreateWithResponse(ctx, client.AgentTokenCreateBodySchema{Name: "ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.JSON200 == nil || created.JSON200.Result == nil || created.JSON200.Result.Token == "" {
+		t.Fatalf("unexpected create response: %s", created.Body)
+	}
+	id := created.JSON200.Result.Id
+
+	updated, err := c.AgentTokensUpdateWithResponse(ctx, id, client.AgentTokenCreateBodySchema{Name: "ci updated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.JSON200 == nil || updated.JSON200.Result.Name != "ci updated" {
+		t.Fatalf("unexpected update response: %s", updated.Body)
+	}
+
+	listed, err := c.AgentTokensIndexWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listed.JSON200 == nil || len(listed.JSON200.Result) != 1 || listed.JSON200.Result[0].Result.Id != id {
+		t.Fatalf("unexpected list response: %s", listed.Body)
+	}
+
+	deleted, err := c.AgentTokensDestroyWithResponse(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := utils.HTTPResponseToError(deleted.StatusCode(), deleted.Body); err != nil {
+		t.Fatal(err)
+	}
+
+	shown, err := c.AgentTokensShowWithResponse(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := utils.HTTPResponseToError(shown.StatusCode(), shown.Body); !errors.Is(err, utils.ErrNotFound) {
+		t.Fatalf("got %v, want utils.ErrNotFound", err)
+	}
+}
+
+func TestServer_resourceInheritsFromIntegration(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	c := newClient(t, srv, nil)
+	owner := srv.AddUser("owner@example.com")
+
+	wf, err := c.WorkflowsCreateWithResponse(ctx, client.WorkflowCreateBodySchema{Name: "wf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	durations := []client.EnumAllowedDurations{3600}
+	integration, err := c.IntegrationsCreateWithResponse(ctx, client.IntegrationCreateBodySchema{
+		Name:             "manual",
+		Application:      client.NameSchema{Name: "manual"},
+		AllowedDurations: &durations,
+		Owner:            client.UserEntitySchema{Id: owner.ID},
+		Workflow:         client.IdParamsSchema{Id: wf.JSON200.Result.Id},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if integration.JSON200 == nil {
+		t.Fatalf("unexpected create response: %s", integration.Body)
+	}
+	if got := integration.JSON200.Result.Owner.Email; got == nil || string(*got) != owner.Email {
+		t.Fatalf("unexpected owner: %s", integration.Body)
+	}
+
+	res, err := c.ResourcesCreateWithResponse(ctx, client.IntegrationResourcesCreateBodySchema{
+		Name:        "bucket",
+		Integration: client.IdParamsSchema{Id: integration.JSON200.Result.Id},
+		Roles:       &[]client.IntegrationResourceRoleCreateSchema{{Name: "read"}, {Name: "write"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.JSON200 == nil {
+		t.Fatalf("unexpected create response: %s", res.Body)
+	}
+
+	result := res.JSON200.Result
+	if result.Workflow == nil || result.Workflow.Name != "wf" || len(result.AllowedDurations) != 1 || result.Owner.Id.String() != owner.ID {
+		t.Fatalf("resource did not inherit the integration settings: %s", res.Body)
+	}
+
+	resourceID := result.Id
+	roles, err := c.RolesIndexWithResponse(ctx, &client.RolesIndexParams{ResourceId: &resourceID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roles.JSON200 == nil || len(roles.JSON200.Result) != 2 {
+		t.Fatalf("expected the inline roles to be created: %s", roles.Body)
+	}
+	if got := roles.JSON200.Result[0].Resource.Integration.Name; got != "manual" {
+		t.Fatalf("got integration name %q, want %q", got, "manual")
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	c := newClient(t, srv, nil)
+
+	for range 5 {
+		if _, err := c.WorkflowsCreateWithResponse(ctx, client.WorkflowCreateBodySchema{Name: "wf"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	page, perPage := float32(3), float32(2)
+	resp, err := c.WorkflowsIndexWithResponse(ctx, &client.WorkflowsIndexParams{Page: &page, PerPage: &perPage})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("unexpected list response: %s", resp.Body)
+	}
+	if len(resp.JSON200.Result) != 1 || resp.JSON200.Pagination.TotalPages != 3 || resp.JSON200.Pagination.TotalResults != 5 {
+		t.Fatalf("unexpected page: %s", resp.Body)
+	}
+}
+
+func TestServer_permissionRevoke(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	c := newClient(t, srv, nil)
+
+	integration, err := c.IntegrationsCreateWithResponse(ctx, client.IntegrationCreateBodySchema{Name: "manual"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.ResourcesCreateWithResponse(ctx, client.IntegrationResourcesCreateBodySchema{
+		Name:        "bucket",
+		Integration: client.IdParamsSchema{Id: integration.JSON200.Result.Id},
+		Roles:       &[]client.IntegrationResourceRoleCreateSchema{{Name: "read"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles, err := c.RolesIndexWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := srv.AddUser("someone@example.com")
+	permissionID := uuid.MustParse(srv.AddPermission(account.ID, roles.JSON200.Result[0].Id.String()))
+
+	resourceID := res.JSON200.Result.Id.String()
+	listed, err := c.PermissionsIndexWithResponse(ctx, &client.PermissionsIndexParams{ResourceId: &resourceID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listed.JSON200 == nil || len(listed.JSON200.Result) != 1 || listed.JSON200.Result[0].PermissionId != permissionID {
+		t.Fatalf("unexpected list response: %s", listed.Body)
+	}
+
+	revoked, err := c.PermissionsRevokeWithResponse(ctx, permissionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := utils.HTTPResponseToError(revoked.StatusCode(), revoked.Body); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Count(fakeentitle.Permissions) != 0 {
+		t.Fatal("expected the permission to be revoked")
+	}
+}
+
+func TestServer_faults(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	srv.InjectFault(fakeentitle.Fault{Method: http.MethodPost, Path: "/agentTokens", Status: http.StatusTooManyRequests, RetryAfter: time.Second})
+	srv.InjectFault(fakeentitle.Fault{Status: http.StatusBadGateway, Count: 2})
+
+	// Without retries the first request fails with the injected status.
+	resp, err := newClient(t, srv, nil).AgentTokensCreateWithResponse(ctx, client.AgentTokenCreateBodySchema{Name: "ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusTooManyRequests || resp.HTTPResponse.Header.Get("Retry-After") != "1" {
+		t.Fatalf("got status %d, Retry-After %q", resp.StatusCode(), resp.HTTPResponse.Header.Get("Retry-After"))
+	}
+
+	// The retrying client gets through the two 502s.
+	c := newClient(t, srv, client.NewRetryDoer(srv.Client(), client.WithBaseBackoff(time.Millisecond)))
+	listed, err := c.AgentTokensIndexWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listed.StatusCode() != http.StatusOK {
+		t.Fatalf("got status %d, want 200", listed.StatusCode())
+	}
+
+	if got := len(srv.Requests()); got != 4 {
+		t.Fatalf("got %d requests, want 4", got)
+	}
+}
+
+func TestServer_requiresBearerToken(t *testing.T) {
+	srv := newServer(t)
+
+	resp, err := srv.Client().Get(srv.URL + "/public/v1/workflows")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("got status %d, want 401", resp.StatusCode)
+	}
+}
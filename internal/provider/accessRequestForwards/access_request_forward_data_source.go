@@ -155,7 +155,7 @@ func (d *AccessRequestForwardDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
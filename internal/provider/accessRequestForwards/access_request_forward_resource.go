@@ -24,6 +24,13 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AccessRequestForwardResource{}
 var _ resource.ResourceWithImportState = &AccessRequestForwardResource{}
+var _ resource.ResourceWithIdentity = &AccessRequestForwardResource{}
+
+// forwardFieldPaths maps forward request body fields to their attributes.
+var forwardFieldPaths = utils.APIFieldPaths{
+	"forwarder": path.Root("forwarder"),
+	"target":    path.Root("target"),
+}
 
 func NewAccessRequestForwardResource() resource.Resource {
 	return &AccessRequestForwardResource{}
@@ -214,8 +221,12 @@ func (r *AccessRequestForwardResource) Create(
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, forwardFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf(
@@ -244,6 +255,8 @@ func (r *AccessRequestForwardResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read is used to read an existing resource of type Entitle Access Request Forward.
@@ -285,7 +298,7 @@ func (r *AccessRequestForwardResource) Read(
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -326,6 +339,8 @@ func (r *AccessRequestForwardResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update handles updates to an existing resource of type Entitle Access Request Forward.
@@ -402,7 +417,7 @@ func (r *AccessRequestForwardResource) Delete(
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -417,6 +432,11 @@ func (r *AccessRequestForwardResource) Delete(
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *AccessRequestForwardResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
 // It extracts the resource's identifier from the import request and sets
@@ -426,5 +446,5 @@ func (r *AccessRequestForwardResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
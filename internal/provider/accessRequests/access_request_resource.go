@@ -0,0 +1,782 @@
+// Package accessRequests provides the implementation of the Entitle Access Request resource for Terraform.
+// It defines the resource type, its schema, and the operations for requesting access to roles and bundles.
+package accessRequests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+const (
+	// defaultWaitTimeout is used when wait_for_approval is enabled without an explicit wait_timeout.
+	defaultWaitTimeout = "30m"
+
+	// pollInterval is the delay between two AccessRequests_show calls while waiting for approval.
+	pollInterval = 10 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccessRequestResource{}
+var _ resource.ResourceWithImportState = &AccessRequestResource{}
+var _ resource.ResourceWithIdentity = &AccessRequestResource{}
+
+// NewAccessRequestResource creates a new instance of the AccessRequestResource.
+func NewAccessRequestResource() resource.Resource {
+	return &AccessRequestResource{}
+}
+
+// AccessRequestResource defines the resource implementation.
+type AccessRequestResource struct {
+	client *client.ClientWithResponses
+}
+
+// AccessRequestResourceModel describes the resource data model.
+type AccessRequestResourceModel struct {
+	ID              types.String             `tfsdk:"id"`
+	Number          types.Int64              `tfsdk:"number"`
+	Status          types.String             `tfsdk:"status"`
+	Role            *utils.IdentityOnlyModel `tfsdk:"role"`
+	Bundle          *utils.IdentityOnlyModel `tfsdk:"bundle"`
+	Duration        types.Int64              `tfsdk:"duration"`
+	Justification   types.String             `tfsdk:"justification"`
+	BehalfOf        types.Object             `tfsdk:"behalf_of"`
+	Roles           types.List               `tfsdk:"roles"`
+	WaitForApproval types.Bool               `tfsdk:"wait_for_approval"`
+	WaitTimeout     types.String             `tfsdk:"wait_timeout"`
+}
+
+// AccessRequestRoleModel describes a role the access request grants.
+type AccessRequestRoleModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	IsPrerequisite types.Bool   `tfsdk:"is_prerequisite"`
+	Resource       types.Object `tfsdk:"resource"`
+}
+
+// AttributeTypes returns the attribute types for AccessRequestRoleModel.
+func (m AccessRequestRoleModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"name":            types.StringType,
+		"is_prerequisite": types.BoolType,
+		"resource": types.ObjectType{
+			AttrTypes: utils.RoleResourceModel{}.AttributeTypes(),
+		},
+	}
+}
+
+// Metadata sets the metadata for the resource.
+func (r *AccessRequestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_request"
+}
+
+// Schema sets the schema for the resource.
+func (r *AccessRequestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.AccessRequestResourceMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Entitle Access Request identifier",
+				Description:         "Entitle Access Request identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The access request's sequential number as shown in the Entitle UI.",
+				Description:         "The access request's sequential number as shown in the Entitle UI.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The access request's status: `waitingForApproval`, `waitingForIT`, `approved`, " +
+					"`permissionInProgress`, `granted`, `rejected`, `cancelled`, `failed` or `revoked`.",
+				Description: "The access request's status: waitingForApproval, waitingForIT, approved, " +
+					"permissionInProgress, granted, rejected, cancelled, failed or revoked.",
+			},
+			"role": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						Description:         "The unique ID of the role to request.",
+						MarkdownDescription: "The unique ID of the role to request.",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The role to request access to. Exactly one of role or bundle must be set.",
+				MarkdownDescription: "The role to request access to. Exactly one of `role` or `bundle` must be set.",
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRoot("role"),
+						path.MatchRoot("bundle"),
+					),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"bundle": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						Description:         "The unique ID of the bundle to request.",
+						MarkdownDescription: "The unique ID of the bundle to request.",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The bundle to request access to. Exactly one of role or bundle must be set.",
+				MarkdownDescription: "The bundle to request access to. Exactly one of `role` or `bundle` must be set.",
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRoot("role"),
+						path.MatchRoot("bundle"),
+					),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The requested access duration in seconds (-1 means unlimited). Must be one of the durations allowed for the requested role or bundle.",
+				MarkdownDescription: "The requested access duration in seconds (`-1` means unlimited). Must be one of the durations allowed for the requested role or bundle.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"justification": schema.StringAttribute{
+				Required:            true,
+				Description:         "The justification for the access request, shown to the approvers.",
+				MarkdownDescription: "The justification for the access request, shown to the approvers.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"behalf_of": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "the user's identifier in uuid format",
+						MarkdownDescription: "the user's identifier in uuid format",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"email": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "the user's email address",
+						MarkdownDescription: "the user's email address",
+						Validators: []validator.String{
+							validators.Email{},
+							validators.Lowercase{},
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Description: "The user the access is requested for. " +
+					"When omitted, the access is requested for the owner of the API key.",
+				MarkdownDescription: "The user the access is requested for. " +
+					"When omitted, the access is requested for the owner of the API key.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The roles resolved from the requested target, including prerequisite roles.",
+				MarkdownDescription: "The roles resolved from the requested target, including prerequisite roles.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The role's unique identifier.",
+							MarkdownDescription: "The role's unique identifier.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The role's name.",
+							MarkdownDescription: "The role's name.",
+						},
+						"is_prerequisite": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the role was added because it is a prerequisite of the requested target.",
+							MarkdownDescription: "Whether the role was added because it is a prerequisite of the requested target.",
+						},
+						"resource": schema.SingleNestedAttribute{
+							Computed:            true,
+							Description:         "The resource the role belongs to.",
+							MarkdownDescription: "The resource the role belongs to.",
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed:            true,
+									Description:         "The unique identifier of the resource.",
+									MarkdownDescription: "The unique identifier of the resource.",
+								},
+								"name": schema.StringAttribute{
+									Computed:            true,
+									Description:         "The display name of the resource.",
+									MarkdownDescription: "The display name of the resource.",
+								},
+								"integration": schema.SingleNestedAttribute{
+									Computed:            true,
+									Description:         "The integration that the resource belongs to.",
+									MarkdownDescription: "The integration that the resource belongs to.",
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Computed:            true,
+											Description:         "The identifier of the integration.",
+											MarkdownDescription: "The identifier of the integration.",
+										},
+										"name": schema.StringAttribute{
+											Computed:            true,
+											Description:         "The display name of the integration.",
+											MarkdownDescription: "The display name of the integration.",
+										},
+										"application": schema.SingleNestedAttribute{
+											Computed:            true,
+											Description:         "The application that the integration is connected to.",
+											MarkdownDescription: "The application that the integration is connected to.",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Computed:            true,
+													Description:         "The name of the connected application.",
+													MarkdownDescription: "The name of the connected application.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"wait_for_approval": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When true, apply blocks after creating the request until it is approved or granted, " +
+					"and fails if it is rejected, cancelled or not approved within wait_timeout (default: false).",
+				MarkdownDescription: "When `true`, apply blocks after creating the request until it is approved or granted, " +
+					"and fails if it is rejected, cancelled or not approved within `wait_timeout` (default: `false`).",
+			},
+			"wait_timeout": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultWaitTimeout),
+				Description:         "How long to wait for approval when wait_for_approval is enabled, as a duration string such as 15m or 2h (default: 30m).",
+				MarkdownDescription: "How long to wait for approval when `wait_for_approval` is enabled, as a duration string such as `15m` or `2h` (default: `30m`).",
+				Validators: []validator.String{
+					validators.Duration{},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *AccessRequestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// Create handles the creation of a new resource of type Entitle Access Request.
+//
+// It reads the Terraform plan data, builds the role or bundle target, sends the
+// access request to the Entitle API and saves the result into Terraform state.
+// When wait_for_approval is set, it then polls the request until it is approved.
+func (r *AccessRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessRequestResourceModel
+
+	// Read Terraform plan data into the model.
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var target client.AccessRequestCreateBodySchema_Target
+	switch {
+	case plan.Role != nil:
+		err := target.FromAccessRequestRoleTargetCreateSchema(client.AccessRequestRoleTargetCreateSchema{
+			Type: client.Role,
+			Role: client.AccessRequestRoleCreateSchema{
+				Id: plan.Role.Id.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Failed to build the role target for the access request, error: %v", err),
+			)
+			return
+		}
+	case plan.Bundle != nil:
+		err := target.FromAccessRequestBundleTargetCreateSchema(client.AccessRequestBundleTargetCreateSchema{
+			Type: client.Bundle,
+			Bundle: client.AccessRequestBundleCreateSchema{
+				Id: plan.Bundle.Id.ValueString(),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Failed to build the bundle target for the access request, error: %v", err),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Client Error",
+			"Failed to create access request resource; one of role or bundle must be set",
+		)
+		return
+	}
+
+	body := client.AccessRequestsCreateJSONRequestBody{
+		Duration:      float32(plan.Duration.ValueInt64()),
+		Justification: plan.Justification.ValueString(),
+		Target:        target,
+	}
+
+	if !plan.BehalfOf.IsNull() && !plan.BehalfOf.IsUnknown() {
+		var behalfOf utils.IdEmailModel
+		diags = plan.BehalfOf.As(ctx, &behalfOf, basetypes.ObjectAsOptions{
+			UnhandledUnknownAsEmpty: true,
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		behalfOfID := behalfOf.Id.ValueString()
+		if behalfOfID == "" {
+			behalfOfID = behalfOf.Email.ValueString()
+		}
+
+		if behalfOfID != "" {
+			body.BehalfOf = &client.UserEntitySchema{Id: behalfOfID}
+		}
+	}
+
+	// Send a request to the Entitle API to create the access request.
+	apiResp, err := r.client.AccessRequestsCreateWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to create the access request, got error: %v", err),
+		)
+		return
+	}
+
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf(
+				"Failed to create the access request, status code: %d, %s",
+				apiResp.HTTPResponse.StatusCode,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	if apiResp.JSON200 == nil || len(apiResp.JSON200.Result) == 0 {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			"Failed to create the access request, the response did not contain the created request",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created an Entitle access request resource")
+
+	result, err := decodeAccessRequest(apiResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to decode the created access request, %s", err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(accessRequestResultToModel(ctx, result, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the data into Terraform state before waiting, so that a failed wait
+	// leaves the created request tracked (and tainted) instead of orphaned.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+
+	if !plan.WaitForApproval.ValueBool() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(plan.WaitTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_timeout"),
+			"Client Error",
+			fmt.Sprintf("Failed to parse wait_timeout (%s), error: %v", plan.WaitTimeout.ValueString(), err),
+		)
+		return
+	}
+
+	polled, err := r.waitForApproval(ctx, result.Id, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Access Request Not Approved",
+			fmt.Sprintf("Access request %s (#%d) was not approved: %s", result.Id, int64(result.Number), err.Error()),
+		)
+	}
+
+	// The poll returns an empty request when its first show failed, keep the
+	// created request in state then.
+	if polled.Id == "" {
+		return
+	}
+
+	resp.Diagnostics.Append(accessRequestResultToModel(ctx, polled, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// Read retrieves an existing resource of type Entitle Access Request.
+//
+// It retrieves the request's data from the Entitle API, maps it to the
+// AccessRequestResourceModel, and saves the data to Terraform state.
+func (r *AccessRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessRequestResourceModel
+
+	// Read Terraform prior state data into the model.
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	// Retrieve the access request details from the Entitle API.
+	result, err := r.show(ctx, id)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			tflog.Debug(ctx, "Resource no longer exists, removing from state")
+
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to get the access request by the id (%s), %s", id, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(accessRequestResultToModel(ctx, result, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the updated data into Terraform state.
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// Update only persists changes to the wait settings; every attribute sent to
+// the Entitle API forces a new access request instead.
+func (r *AccessRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccessRequestResourceModel
+
+	// Read Terraform plan data into the model.
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the updated data into Terraform state.
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// Delete removes the access request from Terraform state.
+//
+// The Entitle API does not support cancelling an access request, so the
+// request and any permission it granted remain until they expire or are revoked.
+func (r *AccessRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessRequestResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Access requests cannot be cancelled through the API, removing from state only",
+		map[string]any{"id": data.ID.ValueString()})
+}
+
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *AccessRequestResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+// ImportState is used to import an existing access request into Terraform.
+//
+// It sets the request ID from the import request along with the default wait
+// settings, so the next plan does not report a spurious change.
+func (r *AccessRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_approval"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_timeout"), defaultWaitTimeout)...)
+}
+
+// show fetches a single access request by its id.
+func (r *AccessRequestResource) show(ctx context.Context, id string) (accessRequestResult, error) {
+	apiResp, err := r.client.AccessRequestsShowWithResponse(ctx, id)
+	if err != nil {
+		return accessRequestResult{}, fmt.Errorf("%w: %v", utils.ErrApiConnection, err)
+	}
+
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+	if err != nil {
+		return accessRequestResult{}, err
+	}
+
+	if apiResp.JSON200 == nil || len(apiResp.JSON200.Result) == 0 {
+		return accessRequestResult{}, utils.ErrNotFound
+	}
+
+	return decodeAccessRequest(apiResp.Body)
+}
+
+// waitForApproval polls the access request until it reaches an approved or a
+// final status, or until timeout elapses. It returns the last polled request
+// so the caller can persist the latest status, or an empty request when no
+// poll succeeded.
+func (r *AccessRequestResource) waitForApproval(
+	ctx context.Context,
+	id string,
+	timeout time.Duration,
+) (accessRequestResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last accessRequestResult
+	for {
+		result, err := r.show(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, fmt.Errorf("timed out after %s waiting for approval, last status: %s", timeout, last.Status)
+			}
+			return last, err
+		}
+		last = result
+
+		tflog.Debug(ctx, "Waiting for access request approval", map[string]any{
+			"id":     id,
+			"status": string(result.Status),
+		})
+
+		switch result.Status {
+		case client.Approved, client.PermissionInProgress, client.Granted:
+			return result, nil
+		case client.Rejected, client.Cancelled, client.Failed, client.Revoked:
+			return result, fmt.Errorf("the request ended with status %s", result.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("timed out after %s waiting for approval, last status: %s", timeout, last.Status)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// accessRequestTarget is the role or bundle an access request targets.
+type accessRequestTarget struct {
+	Type   client.EnumPublicTicketType             `json:"type"`
+	Role   *client.AccessRequestRoleTargetSchema   `json:"role,omitempty"`
+	Bundle *client.AccessRequestBundleTargetSchema `json:"bundle,omitempty"`
+}
+
+// accessRequestResult is an access request along with its target. The
+// generated client only decodes the type of the target union, so the
+// response body is decoded again into it.
+type accessRequestResult struct {
+	client.AccessRequestPublicResultSchema
+	Target accessRequestTarget `json:"target"`
+}
+
+// decodeAccessRequest decodes the first access request of an
+// AccessRequests_create or AccessRequests_show response body.
+func decodeAccessRequest(body []byte) (accessRequestResult, error) {
+	var data struct {
+		Result []accessRequestResult `json:"result"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return accessRequestResult{}, fmt.Errorf("failed to unmarshal the access request: %w", err)
+	}
+
+	if len(data.Result) == 0 {
+		return accessRequestResult{}, utils.ErrNotFound
+	}
+
+	return data.Result[0], nil
+}
+
+// accessRequestResultToModel maps the API access request into the Terraform model,
+// leaving the wait settings untouched.
+func accessRequestResultToModel(
+	ctx context.Context,
+	result accessRequestResult,
+	model *AccessRequestResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = utils.TrimmedStringValue(result.Id)
+	model.Number = types.Int64Value(int64(result.Number))
+	model.Status = utils.TrimmedStringValue(string(result.Status))
+	model.Duration = types.Int64Value(int64(result.Duration))
+	model.Justification = utils.TrimmedStringValue(result.Justification)
+
+	switch {
+	case result.Target.Role != nil:
+		model.Role = &utils.IdentityOnlyModel{Id: utils.TrimmedStringValue(result.Target.Role.Id)}
+		model.Bundle = nil
+	case result.Target.Bundle != nil:
+		model.Role = nil
+		model.Bundle = &utils.IdentityOnlyModel{Id: utils.TrimmedStringValue(result.Target.Bundle.Id)}
+	}
+
+	behalfOf, objDiags := utils.IdEmailModel{
+		Id:    utils.TrimmedStringValue(result.BehalfOf.Id.String()),
+		Email: utils.GetNullableEmailStringValue(result.BehalfOf.Email),
+	}.AsObjectValue(ctx)
+	diags.Append(objDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	model.BehalfOf = behalfOf
+
+	roles := make([]AccessRequestRoleModel, 0, len(result.Roles))
+	for _, role := range result.Roles {
+		res, objDiags := utils.RoleResource{
+			Id:   utils.TrimmedStringValue(role.Resource.Id),
+			Name: utils.TrimmedStringValue(role.Resource.Name),
+			Integration: utils.RoleResourceIntegration{
+				Id:   utils.TrimmedStringValue(role.Resource.Integration.Id),
+				Name: utils.TrimmedStringValue(role.Resource.Integration.Name),
+				Application: utils.NameModel{
+					Name: utils.TrimmedStringValue(role.Resource.Integration.Application.Name),
+				},
+			},
+		}.AsObjectValue(ctx)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		roles = append(roles, AccessRequestRoleModel{
+			ID:             utils.TrimmedStringValue(role.Id),
+			Name:           utils.TrimmedStringValue(role.Name),
+			IsPrerequisite: types.BoolValue(role.IsPrerequisite),
+			Resource:       res,
+		})
+	}
+
+	rolesList, listDiags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: AccessRequestRoleModel{}.AttributeTypes(),
+	}, roles)
+	diags.Append(listDiags...)
+	model.Roles = rolesList
+
+	return diags
+}
@@ -0,0 +1,76 @@
+//go:build acceptance
+
+package accessRequests_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestAccessRequestResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_access_request" "my_request" {
+	role = {
+		id = "%s"
+	}
+	behalf_of = {
+		id = "%s"
+	}
+	duration      = 3600
+	justification = "terraform acceptance test"
+}
+`, os.Getenv("ENTITLE_ROLE_ID"), os.Getenv("ENTITLE_USER1_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_access_request.my_request", "role.id", os.Getenv("ENTITLE_ROLE_ID")),
+					resource.TestCheckResourceAttr("entitle_access_request.my_request", "behalf_of.id", os.Getenv("ENTITLE_USER1_ID")),
+					resource.TestCheckResourceAttr("entitle_access_request.my_request", "duration", "3600"),
+					resource.TestCheckResourceAttr("entitle_access_request.my_request", "justification", "terraform acceptance test"),
+					resource.TestCheckResourceAttr("entitle_access_request.my_request", "wait_for_approval", "false"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_access_request.my_request", "id"),
+					resource.TestCheckResourceAttrSet("entitle_access_request.my_request", "number"),
+					resource.TestCheckResourceAttrSet("entitle_access_request.my_request", "status"),
+					resource.TestCheckResourceAttrSet("entitle_access_request.my_request", "behalf_of.email"),
+					resource.TestCheckResourceAttrSet("entitle_access_request.my_request", "roles.0.id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_access_request.my_request",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccessRequestResource_TargetRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.ProviderConfig + `
+resource "entitle_access_request" "my_request" {
+	duration      = 3600
+	justification = "terraform acceptance test"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
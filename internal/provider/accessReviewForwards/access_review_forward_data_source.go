@@ -154,7 +154,7 @@ func (d *AccessReviewForwardDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -24,6 +24,13 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AccessReviewForwardResource{}
 var _ resource.ResourceWithImportState = &AccessReviewForwardResource{}
+var _ resource.ResourceWithIdentity = &AccessReviewForwardResource{}
+
+// forwardFieldPaths maps forward request body fields to their attributes.
+var forwardFieldPaths = utils.APIFieldPaths{
+	"forwarder": path.Root("forwarder"),
+	"target":    path.Root("target"),
+}
 
 func NewAccessReviewForwardResource() resource.Resource {
 	return &AccessReviewForwardResource{}
@@ -216,8 +223,12 @@ func (r *AccessReviewForwardResource) Create(
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, forwardFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Unable to create the Access Review Forward, got error: %s", err),
@@ -242,6 +253,8 @@ func (r *AccessReviewForwardResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read is used to read an existing resource of type Entitle Access Review Forward.
@@ -283,7 +296,7 @@ func (r *AccessReviewForwardResource) Read(
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -324,6 +337,8 @@ func (r *AccessReviewForwardResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update handles updates to an existing resource of type Entitle Access Review Forward.
@@ -400,7 +415,7 @@ func (r *AccessReviewForwardResource) Delete(
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -414,6 +429,11 @@ func (r *AccessReviewForwardResource) Delete(
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *AccessReviewForwardResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
 // It extracts the resource's identifier from the import request and sets
@@ -423,5 +443,5 @@ func (r *AccessReviewForwardResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,191 @@
+package accessReviewForwards
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure the data source satisfies the framework interface.
+var _ datasource.DataSource = &AccessReviewForwardsDataSource{}
+
+// AccessReviewForwardsDataSource defines the list data source implementation.
+type AccessReviewForwardsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// NewAccessReviewForwardsDataSource creates a new instance of AccessReviewForwardsDataSource.
+func NewAccessReviewForwardsDataSource() datasource.DataSource {
+	return &AccessReviewForwardsDataSource{}
+}
+
+// AccessReviewForwardsDataSourceModel describes the data source model.
+type AccessReviewForwardsDataSourceModel struct {
+	AllPages             types.Bool                       `tfsdk:"all_pages"`
+	Filter               *utils.PaginationWithSearchModel `tfsdk:"filter"`
+	AccessReviewForwards []ForwardListItem                `tfsdk:"access_review_forwards"`
+}
+
+// ForwardListItem represents a single forward in the list.
+type ForwardListItem struct {
+	ID        types.String        `tfsdk:"id"`
+	Forwarder *utils.IdEmailModel `tfsdk:"forwarder"`
+	Target    *utils.IdEmailModel `tfsdk:"target"`
+}
+
+// forwardEmail returns the email of a forward side, or an empty string when it has none.
+func forwardEmail(e client.EntityResponseSchema) string {
+	if e.Email == nil {
+		return ""
+	}
+
+	return string(*e.Email)
+}
+
+// Metadata sets the metadata for the data source.
+func (d *AccessReviewForwardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_review_forwards"
+}
+
+// Schema defines the schema for the data source.
+func (d *AccessReviewForwardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.AccessReviewForwardsDataSourceMarkdownDescription,
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Pagination and filter access review forwards by optional search term.",
+				Attributes: map[string]schema.Attribute{
+					"search": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Search string to filter access review forwards by forwarder or target email. The search lists every page, and `page` and `per_page` then select a page of the matches.",
+					},
+					"page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Page number of results to return (starting from 1). Used together with `per_page` for pagination.",
+					},
+					"per_page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of results to return per page. Defaults to the API's configured page size if not specified.",
+					},
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"all_pages": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Walk every page of results instead of a single page. Cannot be combined with `filter.page`.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("filter").AtName("page")),
+				},
+			},
+			"access_review_forwards": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of access review forwards matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Computed: true},
+						"forwarder": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"id":    schema.StringAttribute{Computed: true},
+								"email": schema.StringAttribute{Computed: true},
+							},
+						},
+						"target": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"id":    schema.StringAttribute{Computed: true},
+								"email": schema.StringAttribute{Computed: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the client used by the data source.
+func (d *AccessReviewForwardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+// Read retrieves access review forwards from the Entitle API using filters.
+func (d *AccessReviewForwardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccessReviewForwardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := utils.ListSearchedPages(ctx, data.Filter, data.AllPages.ValueBool(),
+		func(ctx context.Context, _ *string, page, perPage *int) ([]client.ForwardResponseSchema, int, error) {
+			apiResp, err := d.client.AccessReviewForwardsIndexWithResponse(ctx, &client.AccessReviewForwardsIndexParams{
+				Page:    utils.Float32PointerFromInt(page),
+				PerPage: utils.Float32PointerFromInt(perPage),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(item client.ForwardResponseSchema) []string {
+			return []string{forwardEmail(item.Forwarder), forwardEmail(item.Target)}
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to list access review forwards: %s", err))
+		return
+	}
+
+	forwards := make([]ForwardListItem, len(results))
+	for i, f := range results {
+		forwards[i] = ForwardListItem{
+			ID: types.StringValue(f.Id.String()),
+			Forwarder: &utils.IdEmailModel{
+				Id:    utils.TrimmedStringValue(f.Forwarder.Id.String()),
+				Email: utils.GetNullableEmailStringValue(f.Forwarder.Email),
+			},
+			Target: &utils.IdEmailModel{
+				Id:    utils.TrimmedStringValue(f.Target.Id.String()),
+				Email: utils.GetNullableEmailStringValue(f.Target.Email),
+			},
+		}
+	}
+
+	data.AccessReviewForwards = forwards
+	tflog.Trace(ctx, "Read entitle access review forwards list data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -160,7 +160,7 @@ func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	if err := utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body); err != nil {
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to list accounts: %s", err))
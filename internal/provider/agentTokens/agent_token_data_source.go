@@ -118,7 +118,7 @@ func (d *AgentTokenDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(agentTokenResp.HTTPResponse.StatusCode, agentTokenResp.Body)
+	err = utils.ResponseToError(agentTokenResp.HTTPResponse, agentTokenResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -0,0 +1,260 @@
+package agentTokens
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AgentTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AgentTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &AgentTokenEphemeralResource{}
+
+// agentTokenPrivateKey is the private data key holding the ID of an agent
+// token opened with delete_on_close, so Close can delete it.
+const agentTokenPrivateKey = "agent_token_id"
+
+// NewAgentTokenEphemeralResource creates a new instance of the AgentTokenEphemeralResource.
+func NewAgentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AgentTokenEphemeralResource{}
+}
+
+// AgentTokenEphemeralResource defines the ephemeral resource implementation.
+// It creates agent tokens whose secret is never persisted in plan or state.
+type AgentTokenEphemeralResource struct {
+	client *client.ClientWithResponses
+}
+
+// AgentTokenEphemeralResourceModel describes the ephemeral resource data model.
+type AgentTokenEphemeralResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Rotate        types.Bool   `tfsdk:"rotate"`
+	DeleteOnClose types.Bool   `tfsdk:"delete_on_close"`
+	Token         types.String `tfsdk:"token"`
+}
+
+// Metadata sets the metadata for the ephemeral resource.
+func (r *AgentTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+// Schema sets the schema for the ephemeral resource.
+func (r *AgentTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.AgentTokenEphemeralResourceMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Entitle AgentToken identifier in UUID format",
+				Description:         "Entitle AgentToken identifier in UUID format",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The display name for the agent token.",
+				Description:         "The display name for the agent token.",
+			},
+			"rotate": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, every other agent token with the same `name` is deleted once " +
+					"the new token has been created, so only the latest token stays valid.",
+				Description: "When true, every other agent token with the same name is deleted once " +
+					"the new token has been created, so only the latest token stays valid.",
+			},
+			"delete_on_close": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, the token is deleted once the Terraform run no longer needs it. " +
+					"Only use it for a token consumed during the run itself, never for one written to a secret store.",
+				Description: "When true, the token is deleted once the Terraform run no longer needs it. " +
+					"Only use it for a token consumed during the run itself, never for one written to a secret store.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The token for the agent token. (sensitive)",
+				Description:         "The token for the agent token. (sensitive)",
+			},
+		},
+	}
+}
+
+// Configure configures the ephemeral resource with the provided client.
+func (r *AgentTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// Open creates a new agent token and returns its secret as an ephemeral result.
+//
+// Terraform opens ephemeral resources on every plan and apply, so each run
+// creates a new token that stays valid after the run. Set rotate to delete the
+// tokens created by earlier runs, or delete_on_close to delete this one in Close.
+func (r *AgentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AgentTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	// Send a request to the Entitle API to create the agent token.
+	agentTokenResp, err := r.client.AgentTokensCreateWithResponse(ctx, client.AgentTokenCreateBodySchema{
+		Name: name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to create the agent token, got error: %v", err),
+		)
+		return
+	}
+
+	err = utils.ResponseToError(agentTokenResp.HTTPResponse, agentTokenResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf(
+				"Failed to create the Agent Token, status code: %d, %s",
+				agentTokenResp.HTTPResponse.StatusCode,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created an Entitle agent token ephemeral resource")
+
+	result := agentTokenResp.JSON200.Result
+	data.ID = utils.TrimmedStringValue(result.Id.String())
+	data.Token = utils.TrimmedStringValue(result.Token)
+
+	if data.Rotate.ValueBool() {
+		if err := r.deleteOtherTokens(ctx, name, result.Id.String()); err != nil {
+			resp.Diagnostics.AddError(
+				utils.ErrApiResponse.Error(),
+				fmt.Sprintf("Created the agent token (%s) but failed to rotate the previous ones, %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	if data.DeleteOnClose.ValueBool() {
+		id, err := json.Marshal(result.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to encode the agent token id, error: %v", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, agentTokenPrivateKey, id)...)
+	}
+
+	// Save the data into the ephemeral result.
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the agent token created by Open when delete_on_close is set,
+// and keeps it otherwise.
+func (r *AgentTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, agentTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	var id uuid.UUID
+	if err := json.Unmarshal(data, &id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to decode the agent token id, error: %v", err))
+		return
+	}
+
+	httpResp, err := r.client.AgentTokensDestroyWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to delete the agent token (%s), got error: %v", id, err),
+		)
+		return
+	}
+
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to delete the agent token (%s): %s", id, err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an Entitle agent token ephemeral resource")
+}
+
+// deleteOtherTokens deletes every agent token named name except keepID.
+func (r *AgentTokenEphemeralResource) deleteOtherTokens(ctx context.Context, name, keepID string) error {
+	tokens, err := utils.ListPages(ctx, nil, true,
+		func(ctx context.Context, _ *string, page, perPage *int) ([]client.AgentTokenResponseSchema, int, error) {
+			apiResp, err := r.client.AgentTokensIndexWithResponse(ctx, &client.AgentTokensIndexParams{
+				Page:    utils.Float32PointerFromInt(page),
+				PerPage: utils.Float32PointerFromInt(perPage),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to list agent tokens: %w", err)
+	}
+
+	for _, t := range tokens {
+		if t.Result == nil || t.Result.Name != name || t.Result.Id.String() == keepID {
+			continue
+		}
+
+		tflog.Debug(ctx, "Deleting rotated agent token", map[string]any{"id": t.Result.Id.String()})
+
+		httpResp, err := r.client.AgentTokensDestroyWithResponse(ctx, t.Result.Id)
+		if err != nil {
+			return fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+		}
+
+		err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
+		if err != nil {
+			return fmt.Errorf("failed to delete the agent token (%s): %w", t.Result.Id.String(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package agentTokens_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestAgentTokenEphemeralResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	// A token with the same name is rotated away, one with another name is kept.
+	rotated := srv.Add(fakeentitle.AgentTokens, map[string]any{"name": "My Ephemeral Agent Token"})
+	other := srv.Add(fakeentitle.AgentTokens, map[string]any{"name": "Another Agent Token"})
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"entitle": testhelpers.TestAccProtoV6ProviderFactories["entitle"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			// Open testing: the rotated token outlives the run and replaces
+			// the older ones with the same name.
+			{
+				Config: providerConfig + `
+ephemeral "entitle_agent_token" "my_agent_token" {
+	name   = "My Ephemeral Agent Token"
+	rotate = true
+}
+
+provider "echo" {
+	data = ephemeral.entitle_agent_token.my_agent_token
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("My Ephemeral Agent Token")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+				Check: func(*terraform.State) error {
+					if n := srv.Count(fakeentitle.AgentTokens); n != 2 {
+						return fmt.Errorf("expected the latest token and the unrelated one to be left, got %d tokens", n)
+					}
+					if _, ok := srv.Get(fakeentitle.AgentTokens, rotated); ok {
+						return fmt.Errorf("expected the agent token %s to be rotated away", rotated)
+					}
+					if _, ok := srv.Get(fakeentitle.AgentTokens, other); !ok {
+						return fmt.Errorf("expected the agent token %s to be kept", other)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAgentTokenEphemeralResource_fakeDeleteOnClose(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"entitle": testhelpers.TestAccProtoV6ProviderFactories["entitle"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			// Open and Close testing: every token opened by the run is deleted
+			// when the run ends.
+			{
+				Config: providerConfig + `
+ephemeral "entitle_agent_token" "my_agent_token" {
+	name            = "My Ephemeral Agent Token"
+	delete_on_close = true
+}
+
+provider "echo" {
+	data = ephemeral.entitle_agent_token.my_agent_token
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+				Check: func(*terraform.State) error {
+					if n := srv.Count(fakeentitle.AgentTokens); n != 0 {
+						return fmt.Errorf("expected the opened agent tokens to be deleted, %d tokens left", n)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package agentTokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &AgentTokenListResource{}
+var _ list.ListResourceWithConfigure = &AgentTokenListResource{}
+
+// NewAgentTokenListResource creates a new instance of the AgentTokenListResource.
+func NewAgentTokenListResource() list.ListResource {
+	return &AgentTokenListResource{}
+}
+
+// AgentTokenListResource lists agent tokens for Terraform query.
+type AgentTokenListResource struct {
+	client *client.ClientWithResponses
+}
+
+// AgentTokenListResourceModel describes the list resource configuration.
+type AgentTokenListResourceModel struct {
+	Search types.String `tfsdk:"search"`
+}
+
+// Metadata sets the metadata for the list resource, which lists entitle_agent_token resources.
+func (r *AgentTokenListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *AgentTokenListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.AgentTokenListResourceMarkdownDescription,
+		Attributes: map[string]listschema.Attribute{
+			"search": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Search string to filter agent tokens by name.",
+			},
+		},
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *AgentTokenListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each agent token matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *AgentTokenListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data AgentTokenListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, utils.IDIdentityPaths,
+		func(ctx context.Context, page int) ([]client.AgentTokenResponseSchema, int, error) {
+			apiResp, err := r.client.AgentTokensIndexWithResponse(ctx, &client.AgentTokensIndexParams{
+				Page:    utils.Float32Pointer(float32(page)),
+				PerPage: utils.Float32Pointer(100),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			items := make([]client.AgentTokenResponseSchema, 0, len(apiResp.JSON200.Result))
+			for _, item := range apiResp.JSON200.Result {
+				if item.Result != nil && utils.MatchesSearch(data.Search.ValueStringPointer(), item.Result.Name) {
+					items = append(items, item)
+				}
+			}
+
+			return items, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, t client.AgentTokenResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), t.Result.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("name"), t.Result.Name)...)
+			return t.Result.Name, diags
+		},
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,6 +25,12 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AgentTokenResource{}
 var _ resource.ResourceWithImportState = &AgentTokenResource{}
+var _ resource.ResourceWithIdentity = &AgentTokenResource{}
+
+// agentTokenFieldPaths maps agent token request body fields to their attributes.
+var agentTokenFieldPaths = utils.APIFieldPaths{
+	"name": path.Root("name"),
+}
 
 // NewAgentTokenResource creates a new instance of the AgentTokenResource.
 func NewAgentTokenResource() resource.Resource {
@@ -37,9 +44,10 @@ type AgentTokenResource struct {
 
 // AgentTokenResourceModel describes the resource data model.
 type AgentTokenResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Token types.String `tfsdk:"token"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Token        types.String `tfsdk:"token"`
+	PersistToken types.Bool   `tfsdk:"persist_token"`
 }
 
 // Metadata sets the metadata for the resource.
@@ -73,8 +81,20 @@ func (r *AgentTokenResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description:         "The token for the agent token. (sensitive)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					tokenPersistenceModifier{},
 				},
 			},
+			"persist_token": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether to keep the plaintext `token` in Terraform state. When `false`, the token " +
+					"is dropped from state right after creation; use the `entitle_agent_token` ephemeral resource to " +
+					"hand a token to other resources without persisting it.",
+				Description: "Whether to keep the plaintext token in Terraform state. When false, the token " +
+					"is dropped from state right after creation; use the entitle_agent_token ephemeral resource to " +
+					"hand a token to other resources without persisting it.",
+			},
 		},
 	}
 }
@@ -138,8 +158,12 @@ func (r *AgentTokenResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(agentTokenResp.HTTPResponse.StatusCode, agentTokenResp.Body)
+	err = utils.ResponseToError(agentTokenResp.HTTPResponse, agentTokenResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, agentTokenFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -156,9 +180,14 @@ func (r *AgentTokenResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Update the AgentTokenResourceModel with the created agent token data.
 	plan = AgentTokenResourceModel{
-		ID:    utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Id.String()),
-		Name:  utils.TrimmedStringValue(name),
-		Token: utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Token),
+		ID:           utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Id.String()),
+		Name:         utils.TrimmedStringValue(name),
+		Token:        types.StringNull(),
+		PersistToken: plan.PersistToken,
+	}
+
+	if plan.PersistToken.ValueBool() {
+		plan.Token = utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Token)
 	}
 
 	// Save the data into Terraform state.
@@ -167,6 +196,8 @@ func (r *AgentTokenResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read retrieves an existing resource of type Entitle AgentToken.
@@ -204,7 +235,7 @@ func (r *AgentTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	err = utils.HTTPResponseToError(agentTokenResp.HTTPResponse.StatusCode, agentTokenResp.Body)
+	err = utils.ResponseToError(agentTokenResp.HTTPResponse, agentTokenResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -227,9 +258,15 @@ func (r *AgentTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Update the AgentTokenResourceModel with the retrieved data.
 	data = AgentTokenResourceModel{
-		ID:    utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Id.String()),
-		Name:  utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Name),
-		Token: data.Token,
+		ID:           utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Id.String()),
+		Name:         utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Name),
+		Token:        data.Token,
+		PersistToken: data.PersistToken,
+	}
+
+	// Imported resources and states written before persist_token existed have no value yet.
+	if data.PersistToken.IsNull() {
+		data.PersistToken = types.BoolValue(true)
 	}
 
 	// Save the updated data into Terraform state.
@@ -238,6 +275,8 @@ func (r *AgentTokenResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update handles updates to an existing resource of type Entitle AgentToken.
@@ -289,7 +328,7 @@ func (r *AgentTokenResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(agentTokenResp.HTTPResponse.StatusCode, agentTokenResp.Body)
+	err = utils.ResponseToError(agentTokenResp.HTTPResponse, agentTokenResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -298,6 +337,10 @@ func (r *AgentTokenResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, agentTokenFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -312,9 +355,10 @@ func (r *AgentTokenResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update the AgentTokenResourceModel with the updated agent token data.
 	data = AgentTokenResourceModel{
-		ID:    utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Id.String()),
-		Name:  utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Name),
-		Token: data.Token,
+		ID:           utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Id.String()),
+		Name:         utils.TrimmedStringValue(agentTokenResp.JSON200.Result.Name),
+		Token:        data.Token,
+		PersistToken: data.PersistToken,
 	}
 
 	// Save the updated data into Terraform state.
@@ -323,6 +367,8 @@ func (r *AgentTokenResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Delete is responsible for deleting an existing resource of type Entitle AgentToken.
@@ -362,7 +408,7 @@ func (r *AgentTokenResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -377,10 +423,44 @@ func (r *AgentTokenResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *AgentTokenResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState is used to import an existing resource's state into Terraform.
 //
 // It extracts the resource's identifier from the import request and sets
 // it in Terraform state using resource.ImportStatePassthroughID.
 func (r *AgentTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// tokenPersistenceModifier plans a null token when persist_token is false, so
+// the token is dropped from state on the next apply.
+type tokenPersistenceModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m tokenPersistenceModifier) Description(ctx context.Context) string {
+	return "Plans a null token when persist_token is false."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m tokenPersistenceModifier) MarkdownDescription(ctx context.Context) string {
+	return "Plans a null token when `persist_token` is `false`."
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m tokenPersistenceModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var persist types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("persist_token"), &persist)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if persist.IsUnknown() || persist.IsNull() || persist.ValueBool() {
+		return
+	}
+
+	resp.PlanValue = types.StringNull()
 }
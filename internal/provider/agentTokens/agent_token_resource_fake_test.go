@@ -0,0 +1,69 @@
+package agentTokens_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestAgentTokenResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if n := srv.Count(fakeentitle.AgentTokens); n != 0 {
+				return fmt.Errorf("expected all agent tokens to be destroyed, %d left", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "entitle_agent_token" "my_agent_token" {
+	name = "My Agent Token"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_agent_token.my_agent_token", "name", "My Agent Token"),
+					resource.TestCheckResourceAttrSet("entitle_agent_token.my_agent_token", "id"),
+					resource.TestCheckResourceAttrSet("entitle_agent_token.my_agent_token", "token"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("entitle_agent_token.my_agent_token", tfjsonpath.New("id")),
+				},
+			},
+			// Update testing, with a transient 502 on the first read that the
+			// provider retries.
+			{
+				PreConfig: func() {
+					srv.InjectFault(fakeentitle.Fault{Method: http.MethodGet, Path: "/agentTokens", Status: http.StatusBadGateway})
+				},
+				Config: providerConfig + `
+resource "entitle_agent_token" "my_agent_token" {
+	name = "My Agent Token UPDATED"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_agent_token.my_agent_token", "name", "My Agent Token UPDATED"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "entitle_agent_token.my_agent_token",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "persist_token"},
+			},
+		},
+	})
+}
@@ -44,6 +44,47 @@ resource "entitle_agent_token" "my_agent_token" {
 					resource.TestCheckResourceAttr("entitle_agent_token.my_agent_token", "name", "My Agent Token UPDATED"),
 				),
 			},
+			// Drop token from state testing
+			{
+				Config: testhelpers.ProviderConfig + `
+
+resource "entitle_agent_token" "my_agent_token" {
+	name          = "My Agent Token UPDATED"
+	persist_token = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_agent_token.my_agent_token", "persist_token", "false"),
+					resource.TestCheckNoResourceAttr("entitle_agent_token.my_agent_token", "token"),
+				),
+			},
+		},
+	})
+}
+
+func TestAgentTokenResource_WithoutPersistedToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + `
+
+resource "entitle_agent_token" "my_agent_token" {
+	name          = "My Agent Token Without State"
+	persist_token = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_agent_token.my_agent_token", "name", "My Agent Token Without State"),
+					resource.TestCheckNoResourceAttr("entitle_agent_token.my_agent_token", "token"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_agent_token.my_agent_token", "id"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,162 @@
+package agentTokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure the data source satisfies the framework interface.
+var _ datasource.DataSource = &AgentTokensDataSource{}
+
+// AgentTokensDataSource defines the list data source implementation.
+type AgentTokensDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// NewAgentTokensDataSource creates a new instance of AgentTokensDataSource.
+func NewAgentTokensDataSource() datasource.DataSource {
+	return &AgentTokensDataSource{}
+}
+
+// AgentTokensDataSourceModel describes the data source model.
+type AgentTokensDataSourceModel struct {
+	AllPages    types.Bool                       `tfsdk:"all_pages"`
+	Filter      *utils.PaginationWithSearchModel `tfsdk:"filter"`
+	AgentTokens []utils.IdNameModel              `tfsdk:"agent_tokens"`
+}
+
+// Metadata sets the metadata for the data source.
+func (d *AgentTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_tokens"
+}
+
+// Schema defines the schema for the data source.
+func (d *AgentTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.AgentTokensDataSourceMarkdownDescription,
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Pagination and filter agent tokens by optional search term.",
+				Attributes: map[string]schema.Attribute{
+					"search": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Search string to filter agent tokens by name. The search lists every page, and `page` and `per_page` then select a page of the matches.",
+					},
+					"page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Page number of results to return (starting from 1). Used together with `per_page` for pagination.",
+					},
+					"per_page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of results to return per page. Defaults to the API's configured page size if not specified.",
+					},
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"all_pages": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Walk every page of results instead of a single page. Cannot be combined with `filter.page`.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("filter").AtName("page")),
+				},
+			},
+			"agent_tokens": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of agent tokens matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   schema.StringAttribute{Computed: true},
+						"name": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the client used by the data source.
+func (d *AgentTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+// Read retrieves agent tokens from the Entitle API using filters.
+func (d *AgentTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AgentTokensDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := utils.ListSearchedPages(ctx, data.Filter, data.AllPages.ValueBool(),
+		func(ctx context.Context, _ *string, page, perPage *int) ([]client.AgentTokenResponseSchema, int, error) {
+			apiResp, err := d.client.AgentTokensIndexWithResponse(ctx, &client.AgentTokensIndexParams{
+				Page:    utils.Float32PointerFromInt(page),
+				PerPage: utils.Float32PointerFromInt(perPage),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			items := make([]client.AgentTokenResponseSchema, 0, len(apiResp.JSON200.Result))
+			for _, item := range apiResp.JSON200.Result {
+				if item.Result != nil {
+					items = append(items, item)
+				}
+			}
+
+			return items, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(item client.AgentTokenResponseSchema) []string {
+			return []string{item.Result.Name}
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to list agent tokens: %s", err))
+		return
+	}
+
+	agentTokens := make([]utils.IdNameModel, len(results))
+	for i, t := range results {
+		agentTokens[i] = utils.IdNameModel{
+			ID:   types.StringValue(t.Result.Id.String()),
+			Name: types.StringValue(t.Result.Name),
+		}
+	}
+
+	data.AgentTokens = agentTokens
+	tflog.Trace(ctx, "Read entitle agent tokens list data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,323 @@
+// Package auditLogs provides the implementation of the Entitle Audit Logs data source for Terraform.
+package auditLogs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+const dateLayout = "2006-01-02"
+
+var dateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// Ensure the data source satisfies the framework interface.
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+// AuditLogsDataSource defines the implementation of the audit logs data source.
+type AuditLogsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// NewAuditLogsDataSource creates a new instance of AuditLogsDataSource.
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+// AuditLogsDataSourceModel describes the data source model.
+type AuditLogsDataSourceModel struct {
+	MinDate   types.String   `tfsdk:"min_date"`
+	MaxDate   types.String   `tfsdk:"max_date"`
+	Types     types.Set      `tfsdk:"types"`
+	AuditLogs []AuditLogItem `tfsdk:"audit_logs"`
+}
+
+// AuditLogItem represents a single audit log entry in the list.
+type AuditLogItem struct {
+	CreatedAt types.String    `tfsdk:"created_at"`
+	Type      types.String    `tfsdk:"type"`
+	User      types.String    `tfsdk:"user"`
+	Entity    *AuditLogEntity `tfsdk:"entity"`
+}
+
+// AuditLogEntity represents the Entitle object an audit log entry is about.
+type AuditLogEntity struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
+
+// auditLogResponseBody is the union of every per-entity audit log shape
+// returned by AuditLogs_search. Each entry sets at most one subject field.
+type auditLogResponseBody struct {
+	CreatedAt               time.Time `json:"createdAt"`
+	Type                    string    `json:"type"`
+	User                    *string   `json:"user"`
+	AccessReview            *string   `json:"accessReview"`
+	ApprovalAlgorithm       *string   `json:"approvalAlgorithm"`
+	Bundle                  *string   `json:"bundle"`
+	Integration             *string   `json:"integration"`
+	IntegrationResource     *string   `json:"integrationResource"`
+	IntegrationResourceRole *string   `json:"integrationResourceRole"`
+	Policy                  *float32  `json:"policy"`
+	Ticket                  *float32  `json:"ticket"`
+}
+
+// entity returns the subject of the audit log entry, or nil for company-wide events.
+func (b auditLogResponseBody) entity() *AuditLogEntity {
+	var name string
+	switch {
+	case b.AccessReview != nil:
+		name = *b.AccessReview
+	case b.ApprovalAlgorithm != nil:
+		name = *b.ApprovalAlgorithm
+	case b.Bundle != nil:
+		name = *b.Bundle
+	case b.Integration != nil:
+		name = *b.Integration
+	case b.IntegrationResource != nil:
+		name = *b.IntegrationResource
+	case b.IntegrationResourceRole != nil:
+		name = *b.IntegrationResourceRole
+	case b.Policy != nil:
+		name = strconv.FormatInt(int64(*b.Policy), 10)
+	case b.Ticket != nil:
+		name = strconv.FormatInt(int64(*b.Ticket), 10)
+	default:
+		return nil
+	}
+
+	entityType, _, _ := strings.Cut(b.Type, ".")
+	return &AuditLogEntity{
+		Type: utils.TrimmedStringValue(entityType),
+		Name: utils.TrimmedStringValue(name),
+	}
+}
+
+// Metadata sets the metadata for the data source.
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	eventTypes := make([]string, 0, len(client.EnumAuditLogEventTypeValues))
+	for _, v := range client.EnumAuditLogEventTypeValues {
+		eventTypes = append(eventTypes, string(v))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.AuditLogsDataSourceMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"min_date": schema.StringAttribute{
+				Required:            true,
+				Description:         "Return audit logs created on or after this date (YYYY-MM-DD).",
+				MarkdownDescription: "Return audit logs created on or after this date (`YYYY-MM-DD`).",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex, "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"max_date": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Return audit logs created on or before this date (YYYY-MM-DD).",
+				MarkdownDescription: "Return audit logs created on or before this date (`YYYY-MM-DD`).",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex, "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Only return audit logs of these event types (e.g. integration.updated.owner, policy.created). Returns every type when omitted.",
+				MarkdownDescription: "Only return audit logs of these event types (e.g. `integration.updated.owner`, `policy.created`). Returns every type when omitted.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(eventTypes...)),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of audit logs matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "When the event happened, in RFC 3339 format.",
+							MarkdownDescription: "When the event happened, in RFC 3339 format.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The audit log event type.",
+							MarkdownDescription: "The audit log event type.",
+						},
+						"user": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The user who performed the action, empty for system events.",
+							MarkdownDescription: "The user who performed the action, empty for system events.",
+						},
+						"entity": schema.SingleNestedAttribute{
+							Computed:            true,
+							Description:         "The Entitle object the event is about, null for company-wide events.",
+							MarkdownDescription: "The Entitle object the event is about, null for company-wide events.",
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Computed:            true,
+									Description:         "The object type (e.g. integration, integrationResource, policy, ticket).",
+									MarkdownDescription: "The object type (e.g. `integration`, `integrationResource`, `policy`, `ticket`).",
+								},
+								"name": schema.StringAttribute{
+									Computed:            true,
+									Description:         "The object's name, or its number for policies and tickets.",
+									MarkdownDescription: "The object's name, or its number for policies and tickets.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the client used by the data source.
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+// Read searches the Entitle audit logs using the configured filters.
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minDate, err := time.Parse(dateLayout, data.MinDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_date"),
+			"Invalid Date",
+			fmt.Sprintf("Failed to parse min_date (%s): %s", data.MinDate.ValueString(), err),
+		)
+		return
+	}
+
+	body := client.AuditLogsSearchJSONRequestBody{
+		MinDate: openapi_types.Date{Time: minDate},
+	}
+
+	if !data.MaxDate.IsNull() && !data.MaxDate.IsUnknown() {
+		maxDate, err := time.Parse(dateLayout, data.MaxDate.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_date"),
+				"Invalid Date",
+				fmt.Sprintf("Failed to parse max_date (%s): %s", data.MaxDate.ValueString(), err),
+			)
+			return
+		}
+
+		if maxDate.Before(minDate) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_date"),
+				"Invalid Date",
+				fmt.Sprintf("max_date (%s) must not be before min_date (%s)", data.MaxDate.ValueString(), data.MinDate.ValueString()),
+			)
+			return
+		}
+
+		body.MaxDate = &openapi_types.Date{Time: maxDate}
+	}
+
+	if !data.Types.IsNull() && !data.Types.IsUnknown() {
+		var eventTypes []string
+		resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &eventTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		filter := make([]client.EnumAuditLogEventType, 0, len(eventTypes))
+		for _, t := range eventTypes {
+			filter = append(filter, client.EnumAuditLogEventType(t))
+		}
+		body.Type = &filter
+	}
+
+	apiResp, err := d.client.AuditLogsSearchWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to search audit logs: %s", err))
+		return
+	}
+
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to search audit logs: %s", err))
+		return
+	}
+
+	logs := make([]AuditLogItem, 0, len(apiResp.JSON200.Result))
+	for _, item := range apiResp.JSON200.Result {
+		raw, err := item.MarshalJSON()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to marshal audit log data",
+				err.Error(),
+			)
+			return
+		}
+
+		var entry auditLogResponseBody
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to unmarshal the audit log data (%s)", raw),
+				err.Error(),
+			)
+			return
+		}
+
+		logs = append(logs, AuditLogItem{
+			CreatedAt: types.StringValue(entry.CreatedAt.UTC().Format(time.RFC3339)),
+			Type:      utils.TrimmedStringValue(entry.Type),
+			User:      utils.TrimmedStringValue(utils.StringValue(entry.User)),
+			Entity:    entry.entity(),
+		})
+	}
+
+	data.AuditLogs = logs
+	tflog.Trace(ctx, "Read entitle audit logs data source", map[string]any{"count": len(logs)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
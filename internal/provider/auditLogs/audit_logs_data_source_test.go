@@ -0,0 +1,65 @@
+//go:build acceptance
+
+package auditLogs_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestAuditLogsDataSource(t *testing.T) {
+	minDate := time.Now().AddDate(0, 0, -30).Format("2006-01-02")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testhelpers.ProviderConfig + `
+data "entitle_audit_logs" "my_logs" {
+	min_date = "` + minDate + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.entitle_audit_logs.my_logs", "min_date", minDate),
+					resource.TestCheckResourceAttrSet("data.entitle_audit_logs.my_logs", "audit_logs.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.entitle_audit_logs.my_logs", "audit_logs.0.type"),
+				),
+			},
+			{
+				Config: testhelpers.ProviderConfig + `
+data "entitle_audit_logs" "my_logs" {
+	min_date = "` + minDate + `"
+	types    = ["integration.created", "integration.deleted"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.entitle_audit_logs.my_logs", "types.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAuditLogsDataSource_InvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.ProviderConfig + `
+data "entitle_audit_logs" "my_logs" {
+	min_date = "2025-01-01"
+	types    = ["integration.renamed"]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
@@ -4,14 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
-	openapi_types "github.com/oapi-codegen/runtime/types"
-
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
 
@@ -259,7 +256,7 @@ func (d *BundleDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if data.ID.ValueString() == "" {
 		name := data.Name.ValueString()
 
-		id, err := d.getBundleIDByName(ctx, name)
+		id, err := utils.FindBundleIDByName(ctx, d.client, name)
 		if err != nil {
 			resp.Diagnostics.AddError("Bundle not found", fmt.Sprintf(
 				"Failed to get the Bundle by the name (%s), %s",
@@ -291,7 +288,7 @@ func (d *BundleDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	err = utils.HTTPResponseToError(bundleResp.HTTPResponse.StatusCode, bundleResp.Body)
+	err = utils.ResponseToError(bundleResp.HTTPResponse, bundleResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -326,36 +323,6 @@ func (d *BundleDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	tflog.Trace(ctx, "saved entitle bundle data source successfully!")
 }
 
-// findBundleByID searches the bundle list for the given name.
-func (d *BundleDataSource) getBundleIDByName(ctx context.Context, name string) (*openapi_types.UUID, error) {
-	fetch := func(ctx context.Context, page int) ([]client.BundleIndexResultResponseSchema, int, error) {
-		params := client.BundlesIndexParams{
-			PerPage: utils.Float32Pointer(100),
-			Page:    utils.Float32Pointer(float32(page)),
-		}
-
-		resp, err := d.client.BundlesIndexWithResponse(ctx, &params)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list bundles: %w", err)
-		}
-
-		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
-			return nil, 0, fmt.Errorf("API returned status %d while listing bundles (page %d)",
-				resp.HTTPResponse.StatusCode, page)
-		}
-
-		if resp.JSON200 == nil || resp.JSON200.Result == nil {
-			return nil, 0, fmt.Errorf("received invalid bundle response structure (page %d)", page)
-		}
-
-		items := resp.JSON200.Result
-		total := int(resp.JSON200.Pagination.TotalPages)
-		return items, total, nil
-	}
-
-	return utils.FindIDByName(ctx, name, fetch)
-}
-
 // convertFullBundleResultResponseSchemaToBundleDataSourceModel converts the API response to the data source model.
 // It takes the API response schema and converts it into the expected data source model,
 // handling validations and conversions as necessary.
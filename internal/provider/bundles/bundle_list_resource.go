@@ -0,0 +1,115 @@
+package bundles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &BundleListResource{}
+var _ list.ListResourceWithConfigure = &BundleListResource{}
+
+// NewBundleListResource creates a new instance of the BundleListResource.
+func NewBundleListResource() list.ListResource {
+	return &BundleListResource{}
+}
+
+// BundleListResource lists bundles for Terraform query.
+type BundleListResource struct {
+	client *client.ClientWithResponses
+}
+
+// BundleListResourceModel describes the list resource configuration.
+type BundleListResourceModel struct {
+	Search types.String `tfsdk:"search"`
+}
+
+// Metadata sets the metadata for the list resource, which lists entitle_bundle resources.
+func (r *BundleListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *BundleListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.BundleListResourceMarkdownDescription,
+		Attributes: map[string]listschema.Attribute{
+			"search": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Search string to filter bundles by name.",
+			},
+		},
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *BundleListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each bundle matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *BundleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data BundleListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, utils.IDIdentityPaths,
+		func(ctx context.Context, page int) ([]client.BundleIndexResultResponseSchema, int, error) {
+			apiResp, err := r.client.BundlesIndexWithResponse(ctx, &client.BundlesIndexParams{
+				Page:    utils.Float32Pointer(float32(page)),
+				PerPage: utils.Float32Pointer(100),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			items := make([]client.BundleIndexResultResponseSchema, 0, len(apiResp.JSON200.Result))
+			for _, item := range apiResp.JSON200.Result {
+				if utils.MatchesSearch(data.Search.ValueStringPointer(), item.Name) {
+					items = append(items, item)
+				}
+			}
+
+			return items, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, b client.BundleIndexResultResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), b.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("name"), b.Name)...)
+			return b.Name, diags
+		},
+	)
+}
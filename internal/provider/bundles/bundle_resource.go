@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,11 +24,24 @@ import (
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BundleResource{}
 var _ resource.ResourceWithImportState = &BundleResource{}
+var _ resource.ResourceWithIdentity = &BundleResource{}
+
+// bundleFieldPaths maps bundle request body fields to their attributes.
+var bundleFieldPaths = utils.APIFieldPaths{
+	"name":             path.Root("name"),
+	"description":      path.Root("description"),
+	"category":         path.Root("category"),
+	"tags":             path.Root("tags"),
+	"allowedDurations": path.Root("allowed_durations"),
+	"roles":            path.Root("roles"),
+	"workflow":         path.Root("workflow"),
+}
 
 func NewBundleResource() resource.Resource {
 	return &BundleResource{}
@@ -55,6 +69,9 @@ type BundleResourceModel struct {
 	// AllowedDurations the allowed durations for the resource
 	AllowedDurations types.Set `tfsdk:"allowed_durations" json:"allowedDurations"`
 
+	// AllowedDurationLabels the labels of the allowed durations
+	AllowedDurationLabels types.Set `tfsdk:"allowed_duration_labels" json:"allowedDurationLabels"`
+
 	// Workflow the id and name of the workflows associated with the resource
 	Workflow *utils.IdNameModel `tfsdk:"workflow" json:"workflow"`
 
@@ -117,13 +134,21 @@ func (r *BundleResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			// Attribute: allowed_durations
 			"allowed_durations": schema.SetAttribute{
 				ElementType:         types.NumberType,
-				Required:            true,
-				Description:         "You can override your organization’s default duration on each bundle. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
-				MarkdownDescription: "You can override your organization’s default duration on each bundle. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
+				Optional:            true,
+				Computed:            true,
+				Description:         "You can override your organization’s default duration on each bundle. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nExactly one of allowed_durations and allowed_duration_labels must be set.",
+				MarkdownDescription: "You can override your organization’s default duration on each bundle. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nExactly one of `allowed_durations` and `allowed_duration_labels` must be set.",
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(path.MatchRoot("allowed_duration_labels")),
+					validators.AllowedDurations{},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					utils.AllowedDurationsFromLabels(),
 				},
 			},
+			"allowed_duration_labels": utils.AllowedDurationLabelsAttribute(),
 			// Attribute: tags
 			"tags": schema.SetAttribute{
 				ElementType: types.StringType,
@@ -359,8 +384,12 @@ func (r *BundleResource) Create(
 		return
 	}
 
-	err = utils.HTTPResponseToError(bundleResp.HTTPResponse.StatusCode, bundleResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(bundleResp.HTTPResponse, bundleResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, bundleFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -392,6 +421,8 @@ func (r *BundleResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read is used to read an existing resource of type Entitle Bundle.
@@ -433,7 +464,7 @@ func (r *BundleResource) Read(
 		return
 	}
 
-	err = utils.HTTPResponseToError(bundleResp.HTTPResponse.StatusCode, bundleResp.Body)
+	err = utils.ResponseToError(bundleResp.HTTPResponse, bundleResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -467,6 +498,8 @@ func (r *BundleResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update handles updates to an existing resource of type Entitle Bundle.
@@ -574,7 +607,7 @@ func (r *BundleResource) Update(
 		return
 	}
 
-	err = utils.HTTPResponseToError(bundleResp.HTTPResponse.StatusCode, bundleResp.Body)
+	err = utils.ResponseToError(bundleResp.HTTPResponse, bundleResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -583,6 +616,10 @@ func (r *BundleResource) Update(
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, bundleFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -608,6 +645,8 @@ func (r *BundleResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Delete is responsible for deleting an existing resource of type Entitle Bundle.
@@ -649,7 +688,7 @@ func (r *BundleResource) Delete(
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -664,16 +703,24 @@ func (r *BundleResource) Delete(
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *BundleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
-// It extracts the resource's identifier from the import request and sets
-// it in Terraform state using resource.ImportStatePassthroughID.
+// The import ID is the bundle's UUID or name:<bundle name>.
 func (r *BundleResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateByID(ctx, req, resp, utils.ImportResolvers{
+		Name: func(ctx context.Context, name string) (*uuid.UUID, error) {
+			return utils.FindBundleIDByName(ctx, r.client, name)
+		},
+	})
 }
 
 // convertFullBundleResultResponseSchemaToModel is a utility function used to convert the API response data
@@ -709,6 +756,11 @@ func convertFullBundleResultResponseSchemaToModel(
 		return BundleResourceModel{}, tDiags
 	}
 
+	allowedDurationLabels, tDiags := utils.GetLabelSetFromAllowedDurations(data.AllowedDurations)
+	if tDiags.HasError() {
+		return BundleResourceModel{}, tDiags
+	}
+
 	// Extract tags from the API response
 	tags, diagsTags := utils.GetStringSet(data.Tags)
 	diags.Append(diagsTags...)
@@ -736,13 +788,14 @@ func convertFullBundleResultResponseSchemaToModel(
 
 	// Create the Terraform resource model using the extracted data
 	return BundleResourceModel{
-		ID:               utils.TrimmedStringValue(data.Id.String()),
-		Name:             utils.TrimmedStringValue(data.Name),
-		Description:      utils.TrimmedStringValue(utils.StringValue(data.Description)),
-		Category:         category,
-		AllowedDurations: allowedDurations,
-		Tags:             tags,
-		Workflow:         getWorkflow(data.Workflow),
-		Roles:            roles,
+		ID:                    utils.TrimmedStringValue(data.Id.String()),
+		Name:                  utils.TrimmedStringValue(data.Name),
+		Description:           utils.TrimmedStringValue(utils.StringValue(data.Description)),
+		Category:              category,
+		AllowedDurations:      allowedDurations,
+		AllowedDurationLabels: allowedDurationLabels,
+		Tags:                  tags,
+		Workflow:              getWorkflow(data.Workflow),
+		Roles:                 roles,
 	}, diags
 }
@@ -0,0 +1,355 @@
+package bundles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BundleRoleResource{}
+var _ resource.ResourceWithImportState = &BundleRoleResource{}
+var _ resource.ResourceWithIdentity = &BundleRoleResource{}
+
+// bundleRoleImportFormat is the format of the id and import ID of an
+// entitle_bundle_role.
+const bundleRoleImportFormat = "<bundle_id>/<role_id>"
+
+// bundleRoleIdentityPaths maps the identity attributes to their state
+// attributes.
+var bundleRoleIdentityPaths = utils.IdentityPaths{
+	"bundle_id": path.Root("bundle_id"),
+	"role_id":   path.Root("role_id"),
+}
+
+// NewBundleRoleResource creates a new instance of the BundleRoleResource.
+func NewBundleRoleResource() resource.Resource {
+	return &BundleRoleResource{}
+}
+
+// BundleRoleResource defines the resource implementation. It adds a single
+// role to a bundle, keeping its other roles.
+type BundleRoleResource struct {
+	client *client.ClientWithResponses
+}
+
+// BundleRoleResourceModel describes the resource data model.
+type BundleRoleResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	BundleID types.String `tfsdk:"bundle_id"`
+	RoleID   types.String `tfsdk:"role_id"`
+}
+
+// Metadata sets the metadata for the resource.
+func (r *BundleRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle_role"
+}
+
+// Schema sets the schema for the resource.
+func (r *BundleRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.BundleRoleResourceMarkdownDescription,
+		Description:         "Adds a single role to a bundle, keeping its other roles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the attachment, in the format " + bundleRoleImportFormat + ".",
+				MarkdownDescription: "Identifier of the attachment, in the format `" + bundleRoleImportFormat + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bundle_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The id of the bundle to add the role to.",
+				MarkdownDescription: "The id of the bundle to add the role to.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The id of the role to add to the bundle.",
+				MarkdownDescription: "The id of the role to add to the bundle.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *BundleRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// Create adds the role to the bundle.
+func (r *BundleRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BundleRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundleID, roleID, diags := parseBundleRoleIDs(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateBundleRole(ctx, bundleID, roleID, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to add the role (%s) to the bundle (%s): %s", roleID, bundleID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(bundleID.String() + "/" + roleID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, bundleRoleIdentityPaths)...)
+}
+
+// Read checks the role is still one of the bundle's. When it is not, it is
+// removed from state, so the next apply adds it again.
+func (r *BundleRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BundleRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundleID, roleID, diags := parseBundleRoleIDs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := r.bundleRoles(ctx, bundleID)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to get the roles of the bundle (%s): %s", bundleID, err),
+		)
+		return
+	}
+
+	if !slices.Contains(roles, roleID) {
+		resp.Diagnostics.AddWarning(
+			"Bundle Role Removed",
+			fmt.Sprintf("The role (%s) is no longer a role of the bundle (%s) and will be added again. "+
+				"If the bundle is managed by an entitle_bundle, its roles attribute removes this role on every apply.",
+				roleID, bundleID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, bundleRoleIdentityPaths)...)
+}
+
+// Update stores the plan; every change of the attachment replaces it.
+func (r *BundleRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BundleRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, bundleRoleIdentityPaths)...)
+}
+
+// Delete removes the role from the bundle, keeping its other roles.
+func (r *BundleRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BundleRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundleID, roleID, diags := parseBundleRoleIDs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateBundleRole(ctx, bundleID, roleID, false)
+	if err != nil && !errors.Is(err, utils.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to remove the role (%s) from the bundle (%s): %s", roleID, bundleID, err),
+		)
+	}
+}
+
+// IdentitySchema defines the identity of the role of a bundle: its bundle's
+// and role's IDs.
+func (r *BundleRoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"bundle_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the bundle.",
+			},
+			"role_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the role.",
+			},
+		},
+	}
+}
+
+// ImportState imports the role of a bundle by an ID in the format
+// <bundle_id>/<role_id>, or by its identity.
+func (r *BundleRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = utils.IdentityImportID(ctx, req.Identity, "bundle_id", "role_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ids, err := utils.SplitImportID(importID, bundleRoleImportFormat)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &BundleRoleResourceModel{
+		ID:       types.StringValue(ids[0].String() + "/" + ids[1].String()),
+		BundleID: types.StringValue(ids[0].String()),
+		RoleID:   types.StringValue(ids[1].String()),
+	})...)
+}
+
+// updateBundleRole adds the role to the bundle, or removes it when present is
+// false, with a read-modify-write that keeps the other roles. The bundle is
+// locked meanwhile, so parallel attachments to it do not drop each other's
+// roles.
+func (r *BundleRoleResource) updateBundleRole(ctx context.Context, bundleID, roleID uuid.UUID, present bool) error {
+	unlock := utils.LockObject(utils.ObjectKindBundle, bundleID)
+	defer unlock()
+
+	roles, err := r.bundleRoles(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(roles, roleID) == present {
+		return nil
+	}
+
+	roles = slices.DeleteFunc(roles, func(id uuid.UUID) bool { return id == roleID })
+	if present {
+		roles = append(roles, roleID)
+	}
+
+	// The other settings of the bundle are sent as null when they are part
+	// of a generated update body, so the body has the roles only.
+	params := make([]client.IdParamsSchema, len(roles))
+	for i, id := range roles {
+		params[i] = client.IdParamsSchema{Id: id}
+	}
+
+	body, err := json.Marshal(map[string]any{"roles": params})
+	if err != nil {
+		return err
+	}
+
+	apiResp, err := r.client.BundlesUpdateWithBodyWithResponse(ctx, bundleID, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	return utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+}
+
+// bundleRoles returns the IDs of the roles of the bundle.
+func (r *BundleRoleResource) bundleRoles(ctx context.Context, bundleID uuid.UUID) ([]uuid.UUID, error) {
+	apiResp, err := r.client.BundlesShowWithResponse(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+		return nil, err
+	}
+
+	roles := make([]uuid.UUID, len(apiResp.JSON200.Result.Roles))
+	for i, role := range apiResp.JSON200.Result.Roles {
+		roles[i] = role.Id
+	}
+
+	return roles, nil
+}
+
+// parseBundleRoleIDs parses the bundle and role IDs of the model.
+func parseBundleRoleIDs(data BundleRoleResourceModel) (uuid.UUID, uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	bundleID, err := uuid.Parse(data.BundleID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to parse the bundle id to UUID: %v", err))
+		return uuid.Nil, uuid.Nil, diags
+	}
+
+	roleID, err := uuid.Parse(data.RoleID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to parse the role id to UUID: %v", err))
+		return uuid.Nil, uuid.Nil, diags
+	}
+
+	return bundleID, roleID, diags
+}
@@ -0,0 +1,95 @@
+package bundles_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestBundleRoleResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "GitHub",
+		"application": map[string]any{"name": "github"},
+	})
+	resourceID := srv.Add(fakeentitle.Resources, map[string]any{
+		"name":        "my-repo",
+		"integration": map[string]any{"id": integrationID},
+	})
+	adminID := srv.Add(fakeentitle.Roles, map[string]any{"name": "admin", "resource": map[string]any{"id": resourceID}})
+	readID := srv.Add(fakeentitle.Roles, map[string]any{"name": "read", "resource": map[string]any{"id": resourceID}})
+
+	// The bundle already has a role that the attachment must keep.
+	bundleID := srv.Add(fakeentitle.Bundles, map[string]any{
+		"name":             "My Shared Bundle",
+		"allowedDurations": []any{float64(1800)},
+		"roles":            []any{map[string]any{"id": adminID}},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             checkBundleRoles(srv, bundleID, adminID),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_bundle_role" "my_bundle_role" {
+	bundle_id = "%s"
+	role_id   = "%s"
+}
+`, bundleID, readID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_bundle_role.my_bundle_role", "id", bundleID+"/"+readID),
+					checkBundleRoles(srv, bundleID, adminID, readID),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("entitle_bundle_role.my_bundle_role", map[string]knownvalue.Check{
+						"bundle_id": knownvalue.StringExact(bundleID),
+						"role_id":   knownvalue.StringExact(readID),
+					}),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_bundle_role.my_bundle_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// checkBundleRoles checks the roles of a bundle in the fake are roleIDs, in
+// any order.
+func checkBundleRoles(srv *fakeentitle.Server, bundleID string, roleIDs ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		bundle, ok := srv.Get(fakeentitle.Bundles, bundleID)
+		if !ok {
+			return fmt.Errorf("bundle %s not found", bundleID)
+		}
+
+		var got []string
+		roles, _ := bundle["roles"].([]any)
+		for _, role := range roles {
+			got = append(got, fmt.Sprint(role.(map[string]any)["id"]))
+		}
+
+		slices.Sort(got)
+		want := slices.Sorted(slices.Values(roleIDs))
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected bundle %s roles to be %v, got %v", bundleID, want, got)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+//go:build acceptance
+
+package bundles_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestBundleRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+
+resource "entitle_bundle" "my_bundle" {
+	name = "My Shared Bundle"
+	description = "Description of my shared bundle"
+	allowed_durations = [ 1800 ]
+	workflow = {
+		id = "%s"
+	}
+	roles = [
+		{
+			id = "%s"
+		}
+	]
+
+	lifecycle {
+		ignore_changes = [roles]
+	}
+}
+
+resource "entitle_role" "my_role" {
+	name = "My Bundle Role Example"
+	resource = {
+		id = "%s"
+	}
+	requestable = true
+	allowed_durations = [-1]
+}
+
+resource "entitle_bundle_role" "my_bundle_role" {
+	bundle_id = entitle_bundle.my_bundle.id
+	role_id   = entitle_role.my_role.id
+}
+`, os.Getenv("ENTITLE_WORKFLOW_ID"), os.Getenv("ENTITLE_ROLE_ID"), os.Getenv("ENTITLE_RESOURCE_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttrPair("entitle_bundle_role.my_bundle_role", "bundle_id", "entitle_bundle.my_bundle", "id"),
+					resource.TestCheckResourceAttrPair("entitle_bundle_role.my_bundle_role", "role_id", "entitle_role.my_role", "id"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_bundle_role.my_bundle_role", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_bundle_role.my_bundle_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,54 @@
+//go:build acceptance
+
+package bundles_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestBundlesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testhelpers.ProviderConfig + `
+data "entitle_bundles" "my_list" {
+	filter {
+		page     = 1
+		per_page = 1
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("data.entitle_bundles.my_list", "bundles.#", "1"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("data.entitle_bundles.my_list", "bundles.0.id"),
+					resource.TestCheckResourceAttrSet("data.entitle_bundles.my_list", "bundles.0.name"),
+				),
+			},
+			// Read all pages testing
+			{
+				Config: testhelpers.ProviderConfig + `
+data "entitle_bundles" "my_list" {
+	all_pages = true
+	filter {
+		per_page = 1
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("data.entitle_bundles.my_list", "bundles.0.id"),
+					resource.TestCheckResourceAttrSet("data.entitle_bundles.my_list", "bundles.0.name"),
+				),
+			},
+		},
+	})
+}
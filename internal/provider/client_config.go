@@ -0,0 +1,607 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Environment variables that can be used instead of the retry and timeout settings.
+const (
+	envRequestTimeout            = "ENTITLE_REQUEST_TIMEOUT"
+	envRetryMaxAttempts          = "ENTITLE_RETRY_MAX_ATTEMPTS"
+	envRetryBaseBackoff          = "ENTITLE_RETRY_BASE_BACKOFF"
+	envRetryMaxBackoff           = "ENTITLE_RETRY_MAX_BACKOFF"
+	envRetryBudget               = "ENTITLE_RETRY_BUDGET"
+	envRetryOnServiceUnavailable = "ENTITLE_RETRY_ON_SERVICE_UNAVAILABLE"
+	envRetryOnConnectionReset    = "ENTITLE_RETRY_ON_CONNECTION_RESET"
+)
+
+// regionEndpoints maps the region shorthand to the public Entitle API endpoints.
+var regionEndpoints = map[string]string{
+	"eu": "https://api.entitle.io",
+	"ca": "https://api.ca.entitle.io",
+	"us": "https://api.us.entitle.io",
+}
+
+// TLSModel describes the tls block of the provider configuration.
+type TLSModel struct {
+	CABundleFile   types.String `tfsdk:"ca_bundle_file"`
+	CABundlePEM    types.String `tfsdk:"ca_bundle_pem"`
+	ClientCertFile types.String `tfsdk:"client_cert_file"`
+	ClientCertPEM  types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile  types.String `tfsdk:"client_key_file"`
+	ClientKeyPEM   types.String `tfsdk:"client_key_pem"`
+}
+
+// RetryModel describes the retry block of the provider configuration.
+type RetryModel struct {
+	MaxAttempts               types.Int64  `tfsdk:"max_attempts"`
+	BaseBackoff               types.String `tfsdk:"base_backoff"`
+	MaxBackoff                types.String `tfsdk:"max_backoff"`
+	Budget                    types.String `tfsdk:"budget"`
+	RetryOnServiceUnavailable types.Bool   `tfsdk:"retry_on_service_unavailable"`
+	RetryOnConnectionReset    types.Bool   `tfsdk:"retry_on_connection_reset"`
+}
+
+// RateLimitModel describes the rate_limit block of the provider configuration.
+type RateLimitModel struct {
+	RequestsPerSecond      types.Float64 `tfsdk:"requests_per_second"`
+	Burst                  types.Int64   `tfsdk:"burst"`
+	WriteRequestsPerSecond types.Float64 `tfsdk:"write_requests_per_second"`
+	WriteBurst             types.Int64   `tfsdk:"write_burst"`
+}
+
+// proxyURLAttribute is the schema of the proxy_url provider attribute.
+var proxyURLAttribute = schema.StringAttribute{
+	MarkdownDescription: "URL of an HTTP(S) proxy used for all requests to the Entitle API (e.g. `http://proxy.example.com:3128`). " +
+		"Defaults to the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+	Description: "URL of an HTTP(S) proxy used for all requests to the Entitle API (e.g. http://proxy.example.com:3128). " +
+		"Defaults to the HTTPS_PROXY and NO_PROXY environment variables.",
+	Optional: true,
+}
+
+// tlsBlock is the schema of the tls provider block.
+var tlsBlock = schema.SingleNestedBlock{
+	MarkdownDescription: "TLS settings for the connection to the Entitle API: extra trusted CA certificates " +
+		"and a client certificate for mutual TLS. Certificates and keys are PEM encoded and given either as a file path or inline.",
+	Description: "TLS settings for the connection to the Entitle API: extra trusted CA certificates " +
+		"and a client certificate for mutual TLS. Certificates and keys are PEM encoded and given either as a file path or inline.",
+	Attributes: map[string]schema.Attribute{
+		"ca_bundle_file": schema.StringAttribute{
+			MarkdownDescription: "Path to a PEM file with CA certificates trusted in addition to the system roots.",
+			Description:         "Path to a PEM file with CA certificates trusted in addition to the system roots.",
+			Optional:            true,
+		},
+		"ca_bundle_pem": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded CA certificates trusted in addition to the system roots.",
+			Description:         "PEM encoded CA certificates trusted in addition to the system roots.",
+			Optional:            true,
+		},
+		"client_cert_file": schema.StringAttribute{
+			MarkdownDescription: "Path to the PEM encoded client certificate used for mutual TLS. Requires `client_key_file` or `client_key_pem`.",
+			Description:         "Path to the PEM encoded client certificate used for mutual TLS. Requires client_key_file or client_key_pem.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("client_cert_pem")),
+				stringvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("client_key_file"),
+					path.MatchRelative().AtParent().AtName("client_key_pem"),
+				),
+			},
+		},
+		"client_cert_pem": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded client certificate used for mutual TLS. Requires `client_key_file` or `client_key_pem`.",
+			Description:         "PEM encoded client certificate used for mutual TLS. Requires client_key_file or client_key_pem.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("client_key_file"),
+					path.MatchRelative().AtParent().AtName("client_key_pem"),
+				),
+			},
+		},
+		"client_key_file": schema.StringAttribute{
+			MarkdownDescription: "Path to the PEM encoded private key of the client certificate.",
+			Description:         "Path to the PEM encoded private key of the client certificate.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("client_key_pem")),
+				stringvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("client_cert_file"),
+					path.MatchRelative().AtParent().AtName("client_cert_pem"),
+				),
+			},
+		},
+		"client_key_pem": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded private key of the client certificate.",
+			Description:         "PEM encoded private key of the client certificate.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("client_cert_file"),
+					path.MatchRelative().AtParent().AtName("client_cert_pem"),
+				),
+			},
+		},
+	},
+}
+
+// requestTimeoutAttribute is the schema of the request_timeout provider attribute.
+var requestTimeoutAttribute = schema.StringAttribute{
+	MarkdownDescription: fmt.Sprintf("Timeout of a single HTTP request to the Entitle API, as a duration string (e.g. `10s`). "+
+		"Defaults to `%s`. Can also be set via the `%s` environment variable.", client.DefaultRequestTimeout, envRequestTimeout),
+	Description: fmt.Sprintf("Timeout of a single HTTP request to the Entitle API, as a duration string (e.g. 10s). "+
+		"Defaults to %s. Can also be set via the %s environment variable.", client.DefaultRequestTimeout, envRequestTimeout),
+	Optional: true,
+	Validators: []validator.String{
+		validators.Duration{},
+	},
+}
+
+// retryBlock is the schema of the retry provider block.
+var retryBlock = schema.SingleNestedBlock{
+	MarkdownDescription: "Controls how requests to the Entitle API are retried. " +
+		"429 responses are retried on any method; 502, 504 and transport errors only on idempotent methods.",
+	Description: "Controls how requests to the Entitle API are retried. " +
+		"429 responses are retried on any method; 502, 504 and transport errors only on idempotent methods.",
+	Attributes: map[string]schema.Attribute{
+		"max_attempts": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Maximum number of attempts per request, including the first one. "+
+				"Set to `1` to disable retries. Defaults to `%d`. Can also be set via the `%s` environment variable.",
+				client.DefaultMaxAttempts, envRetryMaxAttempts),
+			Description: fmt.Sprintf("Maximum number of attempts per request, including the first one. "+
+				"Set to 1 to disable retries. Defaults to %d. Can also be set via the %s environment variable.",
+				client.DefaultMaxAttempts, envRetryMaxAttempts),
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"base_backoff": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Wait before the first retry, doubled on every further retry. "+
+				"Defaults to `%s`. Can also be set via the `%s` environment variable.", client.DefaultBaseBackoff, envRetryBaseBackoff),
+			Description: fmt.Sprintf("Wait before the first retry, doubled on every further retry. "+
+				"Defaults to %s. Can also be set via the %s environment variable.", client.DefaultBaseBackoff, envRetryBaseBackoff),
+			Optional: true,
+			Validators: []validator.String{
+				validators.Duration{},
+			},
+		},
+		"max_backoff": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Maximum wait between two attempts, including waits requested by a `Retry-After` header. "+
+				"Defaults to `%s`. Can also be set via the `%s` environment variable.", client.DefaultMaxBackoff, envRetryMaxBackoff),
+			Description: fmt.Sprintf("Maximum wait between two attempts, including waits requested by a Retry-After header. "+
+				"Defaults to %s. Can also be set via the %s environment variable.", client.DefaultMaxBackoff, envRetryMaxBackoff),
+			Optional: true,
+			Validators: []validator.String{
+				validators.Duration{},
+			},
+		},
+		"budget": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Maximum total time spent on a request across all attempts and waits. "+
+				"Defaults to `%s`. Can also be set via the `%s` environment variable.", client.DefaultRetryBudget, envRetryBudget),
+			Description: fmt.Sprintf("Maximum total time spent on a request across all attempts and waits. "+
+				"Defaults to %s. Can also be set via the %s environment variable.", client.DefaultRetryBudget, envRetryBudget),
+			Optional: true,
+			Validators: []validator.String{
+				validators.Duration{},
+			},
+		},
+		"retry_on_service_unavailable": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Also retry `503 Service Unavailable` responses, on any method. "+
+				"Useful during Entitle maintenance windows. Defaults to `false`. Can also be set via the `%s` environment variable.",
+				envRetryOnServiceUnavailable),
+			Description: fmt.Sprintf("Also retry 503 Service Unavailable responses, on any method. "+
+				"Useful during Entitle maintenance windows. Defaults to false. Can also be set via the %s environment variable.",
+				envRetryOnServiceUnavailable),
+			Optional: true,
+		},
+		"retry_on_connection_reset": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Also retry connection reset and connection refused errors on non-idempotent methods. "+
+				"A reset request may already have been processed, so this can duplicate writes. "+
+				"Defaults to `false`. Can also be set via the `%s` environment variable.", envRetryOnConnectionReset),
+			Description: fmt.Sprintf("Also retry connection reset and connection refused errors on non-idempotent methods. "+
+				"A reset request may already have been processed, so this can duplicate writes. "+
+				"Defaults to false. Can also be set via the %s environment variable.", envRetryOnConnectionReset),
+			Optional: true,
+		},
+	},
+}
+
+// rateLimitBlock is the schema of the rate_limit provider block.
+var rateLimitBlock = schema.SingleNestedBlock{
+	MarkdownDescription: "Enables client-side rate limiting, shared by every resource and data source of the provider. " +
+		"Reads (`GET` requests) and writes draw from separate token buckets. " +
+		"A `Retry-After` header on any response pauses both buckets for the requested time.",
+	Description: "Enables client-side rate limiting, shared by every resource and data source of the provider. " +
+		"Reads (GET requests) and writes draw from separate token buckets. " +
+		"A Retry-After header on any response pauses both buckets for the requested time.",
+	Attributes: map[string]schema.Attribute{
+		"requests_per_second": schema.Float64Attribute{
+			MarkdownDescription: fmt.Sprintf("Sustained rate of read requests per second. Defaults to `%g`.", client.DefaultReadRequestsPerSecond),
+			Description:         fmt.Sprintf("Sustained rate of read requests per second. Defaults to %g.", client.DefaultReadRequestsPerSecond),
+			Optional:            true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0.1),
+			},
+		},
+		"burst": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Number of read requests that can be sent at once before the rate applies. Defaults to `%d`.", client.DefaultReadBurst),
+			Description:         fmt.Sprintf("Number of read requests that can be sent at once before the rate applies. Defaults to %d.", client.DefaultReadBurst),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"write_requests_per_second": schema.Float64Attribute{
+			MarkdownDescription: fmt.Sprintf("Sustained rate of write requests per second. Defaults to `%g`.", client.DefaultWriteRequestsPerSecond),
+			Description:         fmt.Sprintf("Sustained rate of write requests per second. Defaults to %g.", client.DefaultWriteRequestsPerSecond),
+			Optional:            true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0.1),
+			},
+		},
+		"write_burst": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Number of write requests that can be sent at once before the rate applies. Defaults to `%d`.", client.DefaultWriteBurst),
+			Description:         fmt.Sprintf("Number of write requests that can be sent at once before the rate applies. Defaults to %d.", client.DefaultWriteBurst),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	},
+}
+
+// endpointFromConfig returns the API server address, taken from endpoint, then region, then
+// the ENTITLE_API_ENDPOINT environment variable, then defaultAPIServer. A configured endpoint
+// must be one of the public regions unless allow_custom_endpoint is set.
+func endpointFromConfig(config *EntitleProviderModel, diags *diag.Diagnostics) string {
+	if !config.Endpoint.IsNull() {
+		server := config.Endpoint.ValueString()
+		validateEndpoint(server, config.AllowCustomEndpoint.ValueBool(), diags)
+		return server
+	}
+
+	if !config.Region.IsNull() {
+		return regionEndpoints[config.Region.ValueString()]
+	}
+
+	if server := os.Getenv("ENTITLE_API_ENDPOINT"); server != "" {
+		return server
+	}
+
+	return defaultAPIServer
+}
+
+// validateEndpoint checks that a configured endpoint is one of the public regions or, when
+// custom endpoints are allowed, an HTTPS URL. Plain HTTP is only accepted for loopback hosts,
+// so a local stand-in of the API can be used during development.
+func validateEndpoint(server string, allowCustom bool, diags *diag.Diagnostics) {
+	endpointPath := path.Root("endpoint")
+
+	for _, known := range regionEndpoints {
+		if server == known {
+			return
+		}
+	}
+
+	if !allowCustom {
+		known := make([]string, 0, len(regionEndpoints))
+		for _, v := range regionEndpoints {
+			known = append(known, v)
+		}
+		slices.Sort(known)
+
+		diags.AddAttributeError(
+			endpointPath,
+			"Unsupported Entitle API Endpoint",
+			fmt.Sprintf("The endpoint must be one of %v, got: %q. "+
+				"Set allow_custom_endpoint = true to use another URL.", known, server),
+		)
+		return
+	}
+
+	u, err := url.Parse(server)
+	if err != nil || u.Host == "" {
+		diags.AddAttributeError(
+			endpointPath,
+			"Invalid Entitle API Endpoint",
+			fmt.Sprintf("The endpoint must be an absolute URL, got: %q.", server),
+		)
+		return
+	}
+
+	if u.Scheme == "https" || (u.Scheme == "http" && isLoopbackHost(u.Hostname())) {
+		return
+	}
+
+	diags.AddAttributeError(
+		endpointPath,
+		"Invalid Entitle API Endpoint",
+		fmt.Sprintf("The endpoint must use https (http is only allowed for localhost), got: %q.", server),
+	)
+}
+
+// isLoopbackHost reports whether host is localhost or a loopback IP address.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// httpTransportFromConfig builds the transport of the API client from the proxy_url attribute
+// and the tls block. It starts from a clone of http.DefaultTransport, so the proxy environment
+// variables and the system CA pool keep applying when nothing is configured.
+func httpTransportFromConfig(config *EntitleProviderModel, diags *diag.Diagnostics) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL, err := url.Parse(config.ProxyURL.ValueString())
+		if err != nil || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("The proxy_url must be an absolute URL, got: %q.", config.ProxyURL.ValueString()),
+			)
+		} else {
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
+
+	if config.TLS == nil {
+		return transport
+	}
+
+	tlsPath := path.Root("tls")
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	caFile, caInline := config.TLS.CABundleFile, config.TLS.CABundlePEM
+	if !caFile.IsNull() || !caInline.IsNull() {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		appendCAs := func(pem []byte, p path.Path) {
+			if len(pem) > 0 && !pool.AppendCertsFromPEM(pem) {
+				diags.AddAttributeError(p, "Invalid CA Bundle", "No PEM encoded certificate could be parsed from the CA bundle.")
+			}
+		}
+		appendCAs(pemFromConfig(caFile, tlsPath.AtName("ca_bundle_file"), diags), tlsPath.AtName("ca_bundle_file"))
+		appendCAs([]byte(caInline.ValueString()), tlsPath.AtName("ca_bundle_pem"))
+
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM := pemFromConfig(config.TLS.ClientCertFile, tlsPath.AtName("client_cert_file"), diags)
+	if !config.TLS.ClientCertPEM.IsNull() {
+		certPEM = []byte(config.TLS.ClientCertPEM.ValueString())
+	}
+
+	keyPEM := pemFromConfig(config.TLS.ClientKeyFile, tlsPath.AtName("client_key_file"), diags)
+	if !config.TLS.ClientKeyPEM.IsNull() {
+		keyPEM = []byte(config.TLS.ClientKeyPEM.ValueString())
+	}
+
+	if len(certPEM) > 0 && len(keyPEM) > 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			diags.AddAttributeError(
+				tlsPath,
+				"Invalid Client Certificate",
+				fmt.Sprintf("Unable to load the client certificate and key, got error: %v", err),
+			)
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport
+}
+
+// pemFromConfig reads the PEM file at the path held by v. It returns nil when v is not set.
+func pemFromConfig(v types.String, p path.Path, diags *diag.Diagnostics) []byte {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	data, err := os.ReadFile(v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Unable to Read PEM File", err.Error())
+		return nil
+	}
+
+	return data
+}
+
+// rateLimitOptionsFromConfig builds the RateLimitDoer options from the rate_limit block.
+// Unset attributes keep the client defaults.
+func rateLimitOptionsFromConfig(rateLimit *RateLimitModel) []client.RateLimitOption {
+	readRate, readBurst := client.DefaultReadRequestsPerSecond, client.DefaultReadBurst
+	if !rateLimit.RequestsPerSecond.IsNull() {
+		readRate = rateLimit.RequestsPerSecond.ValueFloat64()
+	}
+	if !rateLimit.Burst.IsNull() {
+		readBurst = int(rateLimit.Burst.ValueInt64())
+	}
+
+	writeRate, writeBurst := client.DefaultWriteRequestsPerSecond, client.DefaultWriteBurst
+	if !rateLimit.WriteRequestsPerSecond.IsNull() {
+		writeRate = rateLimit.WriteRequestsPerSecond.ValueFloat64()
+	}
+	if !rateLimit.WriteBurst.IsNull() {
+		writeBurst = int(rateLimit.WriteBurst.ValueInt64())
+	}
+
+	return []client.RateLimitOption{
+		client.WithReadLimit(readRate, readBurst),
+		client.WithWriteLimit(writeRate, writeBurst),
+	}
+}
+
+// requestTimeoutFromConfig returns the per-request timeout, taken from the configuration,
+// then the environment, then client.DefaultRequestTimeout.
+func requestTimeoutFromConfig(config *EntitleProviderModel, diags *diag.Diagnostics) time.Duration {
+	timeout := client.DefaultRequestTimeout
+	if d, ok := durationSetting(config.RequestTimeout, envRequestTimeout, path.Root("request_timeout"), diags); ok {
+		timeout = d
+	}
+
+	return timeout
+}
+
+// retryOptionsFromConfig builds the RetryDoer options from the retry block, falling back to
+// the matching environment variables for every setting that is not configured.
+func retryOptionsFromConfig(config *EntitleProviderModel, diags *diag.Diagnostics) []client.RetryOption {
+	retry := config.Retry
+	if retry == nil {
+		retry = &RetryModel{}
+	}
+
+	blockPath := path.Root("retry")
+	var opts []client.RetryOption
+
+	if n, ok := intSetting(retry.MaxAttempts, envRetryMaxAttempts, diags); ok {
+		opts = append(opts, client.WithMaxAttempts(int(n)))
+	}
+
+	baseBackoff := client.DefaultBaseBackoff
+	if d, ok := durationSetting(retry.BaseBackoff, envRetryBaseBackoff, blockPath.AtName("base_backoff"), diags); ok {
+		baseBackoff = d
+		opts = append(opts, client.WithBaseBackoff(d))
+	}
+
+	maxBackoff := client.DefaultMaxBackoff
+	if d, ok := durationSetting(retry.MaxBackoff, envRetryMaxBackoff, blockPath.AtName("max_backoff"), diags); ok {
+		maxBackoff = d
+		opts = append(opts, client.WithMaxBackoff(d))
+	}
+
+	if baseBackoff > maxBackoff {
+		diags.AddAttributeError(
+			blockPath.AtName("base_backoff"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("base_backoff (%s) must not be greater than max_backoff (%s).", baseBackoff, maxBackoff),
+		)
+	}
+
+	if d, ok := durationSetting(retry.Budget, envRetryBudget, blockPath.AtName("budget"), diags); ok {
+		opts = append(opts, client.WithRetryBudget(d))
+	}
+
+	if v, ok := boolSetting(retry.RetryOnServiceUnavailable, envRetryOnServiceUnavailable, diags); ok {
+		opts = append(opts, client.WithRetryOnServiceUnavailable(v))
+	}
+
+	if v, ok := boolSetting(retry.RetryOnConnectionReset, envRetryOnConnectionReset, diags); ok {
+		opts = append(opts, client.WithRetryOnConnectionReset(v))
+	}
+
+	return opts
+}
+
+// durationSetting returns the duration from the configuration value, or from the environment
+// variable when the value is not configured. The second result is false when neither is set
+// or the environment variable is invalid; invalid configuration values are rejected by the
+// schema validators before Configure runs.
+func durationSetting(v types.String, env string, p path.Path, diags *diag.Diagnostics) (time.Duration, bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		d, err := time.ParseDuration(v.ValueString())
+		if err != nil {
+			diags.AddAttributeError(p, "Invalid Duration", err.Error())
+			return 0, false
+		}
+		return d, true
+	}
+
+	s := os.Getenv(env)
+	if s == "" {
+		return 0, false
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		diags.AddError(
+			"Invalid Duration",
+			fmt.Sprintf("The %s environment variable must be a positive duration string (e.g. 30s), got: %q.", env, s),
+		)
+		return 0, false
+	}
+
+	return d, true
+}
+
+// intSetting returns the positive integer from the configuration value, or from the environment
+// variable when the value is not configured. The second result is false when neither is set
+// or the environment variable is invalid.
+func intSetting(v types.Int64, env string, diags *diag.Diagnostics) (int64, bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueInt64(), true
+	}
+
+	s := os.Getenv(env)
+	if s == "" {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 1 {
+		diags.AddError(
+			"Invalid Integer",
+			fmt.Sprintf("The %s environment variable must be a positive integer, got: %q.", env, s),
+		)
+		return 0, false
+	}
+
+	return n, true
+}
+
+// boolSetting returns the bool from the configuration value, or from the environment variable
+// when the value is not configured. The second result is false when neither is set.
+func boolSetting(v types.Bool, env string, diags *diag.Diagnostics) (bool, bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueBool(), true
+	}
+
+	s := os.Getenv(env)
+	if s == "" {
+		return false, false
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		diags.AddError(
+			"Invalid Boolean",
+			fmt.Sprintf("The %s environment variable must be a boolean (true or false), got: %q.", env, s),
+		)
+		return false, false
+	}
+
+	return b, true
+}
@@ -146,7 +146,7 @@ func (d *DirectoryGroupsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	if err := utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body); err != nil {
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to list Directory Groups: %s", err))
@@ -0,0 +1,113 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ApprovalStepFunction{}
+
+// NewApprovalStepFunction creates a new instance of the ApprovalStepFunction.
+func NewApprovalStepFunction() function.Function {
+	return &ApprovalStepFunction{}
+}
+
+// ApprovalStepFunction defines the approval_step function, which builds a
+// step of an entitle_workflow approval flow.
+type ApprovalStepFunction struct{}
+
+// stepAttributeTypes returns the attribute types of the approval flow steps
+// of an entitle_workflow.
+func stepAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"operator":          types.StringType,
+		"sort_order":        types.NumberType,
+		"approval_entities": types.ListType{ElemType: entityObjectType()},
+		"notified_entities": types.ListType{ElemType: entityObjectType()},
+	}
+}
+
+// Metadata sets the metadata for the function.
+func (f *ApprovalStepFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "approval_step"
+}
+
+// Definition sets the definition for the function.
+func (f *ApprovalStepFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a step of a workflow approval flow",
+		MarkdownDescription: docs.ApprovalStepFunctionMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "operator",
+				MarkdownDescription: "How the approval entities are combined, `and` or `or`.",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name: "entities",
+			MarkdownDescription: "The approval entities: the results of the `user` and `group` functions, " +
+				"entity objects such as `{ type = \"Webhook\", webhook = { id = \"...\" } }`, or the names of " +
+				"the types without a nested object, such as `\"Automatic\"` or `\"DirectManager\"`.",
+		},
+		Return: function.ObjectReturn{AttributeTypes: stepAttributeTypes()},
+	}
+}
+
+// Run builds the approval step.
+func (f *ApprovalStepFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var operator string
+	var entities []types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &operator, &entities)
+	if resp.Error != nil {
+		return
+	}
+
+	switch client.EnumApprovalFlowStepOperator(operator) {
+	case client.EnumApprovalFlowStepOperatorAnd, client.EnumApprovalFlowStepOperatorOr:
+	default:
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an operator, expected and or or", operator))
+		return
+	}
+
+	if len(entities) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "an approval step needs at least one approval entity")
+		return
+	}
+
+	approvalEntities := make([]attr.Value, len(entities))
+	for i, entity := range entities {
+		value, err := entityFromValue(entity)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("entity %d: %s", i+1, err))
+			return
+		}
+		approvalEntities[i] = value
+	}
+
+	approvalList, diags := types.ListValue(entityObjectType(), approvalEntities)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	step, diags := types.ObjectValue(stepAttributeTypes(), map[string]attr.Value{
+		"operator":          types.StringValue(operator),
+		"sort_order":        types.NumberNull(),
+		"approval_entities": approvalList,
+		"notified_entities": types.ListNull(entityObjectType()),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, step)
+}
@@ -0,0 +1,86 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DurationFunction{}
+
+// NewDurationFunction creates a new instance of the DurationFunction.
+func NewDurationFunction() function.Function {
+	return &DurationFunction{}
+}
+
+// DurationFunction defines the duration function, which converts a duration
+// such as "1h" to the allowed duration value in seconds.
+type DurationFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f *DurationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration"
+}
+
+// Definition sets the definition for the function.
+func (f *DurationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts a duration to an allowed duration in seconds",
+		MarkdownDescription: docs.DurationFunctionMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "duration",
+				MarkdownDescription: "An allowed duration label, such as `1h`, `7d` or `permanent`, or a Go duration " +
+					"string, such as `24h`, that equals an allowed duration.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+// Run converts the duration argument to seconds.
+func (f *DurationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var duration string
+	resp.Error = req.Arguments.Get(ctx, &duration)
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := parseAllowedDuration(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, big.NewFloat(float64(value)))
+}
+
+// parseAllowedDuration returns the allowed duration of a label, or of a Go
+// duration string equal to an allowed duration.
+func parseAllowedDuration(duration string) (client.EnumAllowedDurations, error) {
+	if value, ok := client.EnumAllowedDurationsFromLabel(duration); ok {
+		return value, nil
+	}
+
+	if d, err := time.ParseDuration(duration); err == nil && d%time.Second == 0 {
+		value := client.EnumAllowedDurations(d / time.Second)
+		if _, ok := value.Label(); ok {
+			return value, nil
+		}
+	}
+
+	labels := make([]string, len(client.EnumAllowedDurationsLabels))
+	for i, l := range client.EnumAllowedDurationsLabels {
+		labels[i] = l.Label
+	}
+
+	return 0, fmt.Errorf("%q is not an allowed duration, expected one of %s", duration, strings.Join(labels, ", "))
+}
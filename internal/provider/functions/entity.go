@@ -0,0 +1,169 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// entityObjectType is the type of the workflow approval and notified entity
+// objects the functions return.
+func entityObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: utils.WorkflowEntityAttributeTypes()}
+}
+
+// newEntity returns a workflow entity object of entityType, with nested set
+// as the attribute utils.WorkflowEntityTypes maps the type to.
+func newEntity(entityType string, nested map[string]attr.Value) (types.Object, error) {
+	attrTypes := utils.WorkflowEntityAttributeTypes()
+
+	attributes := map[string]attr.Value{"type": types.StringValue(entityType)}
+	for name, attrType := range attrTypes {
+		if name == "type" {
+			continue
+		}
+
+		objectType := attrType.(types.ObjectType)
+		if name != utils.WorkflowEntityTypes[entityType] || nested == nil {
+			attributes[name] = types.ObjectNull(objectType.AttrTypes)
+			continue
+		}
+
+		value, diags := types.ObjectValue(objectType.AttrTypes, nested)
+		if diags.HasError() {
+			return types.Object{}, fmt.Errorf("invalid %s: %s", name, diags.Errors()[0].Detail())
+		}
+		attributes[name] = value
+	}
+
+	value, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return types.Object{}, fmt.Errorf("invalid entity: %s", diags.Errors()[0].Detail())
+	}
+
+	return value, nil
+}
+
+// entityFromValue converts an entity given to a function, which is either the
+// name of a type without a nested object, such as "Automatic", or an object
+// with a type and the matching nested object, to a workflow entity object.
+func entityFromValue(value attr.Value) (types.Object, error) {
+	switch value := value.(type) {
+	case types.Dynamic:
+		if value.IsNull() || value.IsUnderlyingValueNull() {
+			return types.Object{}, fmt.Errorf("the entity is null")
+		}
+		if value.IsUnknown() || value.IsUnderlyingValueUnknown() {
+			return types.ObjectUnknown(utils.WorkflowEntityAttributeTypes()), nil
+		}
+
+		return entityFromValue(value.UnderlyingValue())
+	case types.String:
+		entityType := value.ValueString()
+		attribute, ok := utils.WorkflowEntityTypes[entityType]
+		if !ok {
+			return types.Object{}, fmt.Errorf("%q is not an entity type, expected one of %s", entityType, utils.WorkflowEntityTypeNames())
+		}
+		if attribute != "" {
+			return types.Object{}, fmt.Errorf("a %s entity needs its %s, pass an object or use the user and group functions", entityType, attribute)
+		}
+
+		return newEntity(entityType, nil)
+	case types.Object:
+		return entityFromObject(value)
+	default:
+		return types.Object{}, fmt.Errorf("expected an entity object or type name, got %s", value.Type(context.Background()))
+	}
+}
+
+// entityFromObject converts an entity object, such as one written as an HCL
+// object literal, to a workflow entity object.
+func entityFromObject(value types.Object) (types.Object, error) {
+	attrTypes := utils.WorkflowEntityAttributeTypes()
+	attributes := value.Attributes()
+
+	for name := range attributes {
+		if _, ok := attrTypes[name]; !ok {
+			return types.Object{}, fmt.Errorf("unsupported entity attribute %q", name)
+		}
+	}
+
+	entityType, ok := attributes["type"].(types.String)
+	if !ok || entityType.IsNull() {
+		return types.Object{}, fmt.Errorf("the entity type is missing")
+	}
+	if entityType.IsUnknown() {
+		return types.ObjectUnknown(attrTypes), nil
+	}
+
+	attribute, ok := utils.WorkflowEntityTypes[entityType.ValueString()]
+	if !ok {
+		return types.Object{}, fmt.Errorf("%q is not an entity type, expected one of %s", entityType.ValueString(), utils.WorkflowEntityTypeNames())
+	}
+
+	for name, nested := range attributes {
+		if name == "type" || name == attribute || nested.IsNull() {
+			continue
+		}
+
+		return types.Object{}, fmt.Errorf("a %s entity does not take a %s", entityType.ValueString(), name)
+	}
+
+	if attribute == "" {
+		return newEntity(entityType.ValueString(), nil)
+	}
+
+	nested, err := nestedFromValue(attribute, attributes[attribute], attrTypes[attribute].(types.ObjectType))
+	if err != nil {
+		return types.Object{}, err
+	}
+
+	return newEntity(entityType.ValueString(), nested)
+}
+
+// nestedFromValue converts the nested object of an entity to the attributes of
+// objectType, leaving out attributes null.
+func nestedFromValue(name string, value attr.Value, objectType types.ObjectType) (map[string]attr.Value, error) {
+	object, ok := value.(types.Object)
+	if !ok || object.IsNull() {
+		return nil, fmt.Errorf("the entity %s is missing", name)
+	}
+
+	nested := map[string]attr.Value{}
+	for key := range objectType.AttrTypes {
+		nested[key] = types.StringNull()
+	}
+
+	if object.IsUnknown() {
+		for key := range objectType.AttrTypes {
+			nested[key] = types.StringUnknown()
+		}
+
+		return nested, nil
+	}
+
+	for key, v := range object.Attributes() {
+		if _, ok := objectType.AttrTypes[key]; !ok {
+			keys := make([]string, 0, len(objectType.AttrTypes))
+			for k := range objectType.AttrTypes {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+
+			return nil, fmt.Errorf("unsupported %s attribute %q, expected one of %v", name, key, keys)
+		}
+
+		s, ok := v.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("the %s %s must be a string", name, key)
+		}
+		nested[key] = s
+	}
+
+	return nested, nil
+}
@@ -0,0 +1,173 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// run calls f with args and returns its result.
+func run(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+
+	// Variadic arguments are passed as a tuple, as the framework does.
+	if def.Definition.VariadicParameter != nil {
+		n := len(def.Definition.Parameters)
+		variadic := args[n:]
+		elemTypes := make([]attr.Type, len(variadic))
+		for i, v := range variadic {
+			elemTypes[i] = v.Type(ctx)
+		}
+		args = append(args[:n:n], types.TupleValueMust(elemTypes, variadic))
+	}
+
+	resp := function.RunResponse{Result: function.NewResultData(def.Definition.Return.GetType().ValueType(ctx))}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestDurationFunction(t *testing.T) {
+	for in, want := range map[string]float64{
+		"30m":       1800,
+		"12h":       43200,
+		"1d":        86400,
+		"24h":       86400,
+		"permanent": -1,
+	} {
+		got, err := run(t, NewDurationFunction(), types.StringValue(in))
+		if err != nil {
+			t.Errorf("duration(%q): %s", in, err)
+			continue
+		}
+		if !got.Equal(types.NumberValue(big.NewFloat(want))) {
+			t.Errorf("duration(%q) = %s, want %v", in, got, want)
+		}
+	}
+
+	for _, in := range []string{"8h", "1.5s", "", "forever"} {
+		if _, err := run(t, NewDurationFunction(), types.StringValue(in)); err == nil {
+			t.Errorf("duration(%q) should fail", in)
+		}
+	}
+}
+
+func TestUserAndGroupFunctions(t *testing.T) {
+	const id = "7d080bfa-9143-11ee-b9d1-0242ac120001"
+
+	for _, tc := range []struct {
+		f          function.Function
+		in         string
+		attribute  string
+		key, value string
+	}{
+		{NewUserFunction(), id, "user", "id", id},
+		{NewUserFunction(), "alice@example.com", "user", "email", "alice@example.com"},
+		{NewGroupFunction(), id, "group", "id", id},
+		{NewGroupFunction(), "Security", "group", "name", "Security"},
+	} {
+		got, err := run(t, tc.f, types.StringValue(tc.in))
+		if err != nil {
+			t.Errorf("%s(%q): %s", tc.attribute, tc.in, err)
+			continue
+		}
+
+		attributes := got.(types.Object).Attributes()
+		nested, ok := attributes[tc.attribute].(types.Object)
+		if !ok || nested.IsNull() {
+			t.Errorf("%s(%q) = %s, want a %s", tc.attribute, tc.in, got, tc.attribute)
+			continue
+		}
+		if v := nested.Attributes()[tc.key]; !v.Equal(types.StringValue(tc.value)) {
+			t.Errorf("%s(%q).%s.%s = %s, want %q", tc.attribute, tc.in, tc.attribute, tc.key, v, tc.value)
+		}
+		for name, v := range attributes {
+			if name != "type" && name != tc.attribute && !v.IsNull() {
+				t.Errorf("%s(%q).%s = %s, want null", tc.attribute, tc.in, name, v)
+			}
+		}
+	}
+
+	if _, err := run(t, NewUserFunction(), types.StringValue("alice")); err == nil {
+		t.Error(`user("alice") should fail`)
+	}
+	if _, err := run(t, NewGroupFunction(), types.StringValue(" ")); err == nil {
+		t.Error(`group(" ") should fail`)
+	}
+}
+
+func TestApprovalStepFunction(t *testing.T) {
+	user, err := run(t, NewUserFunction(), types.StringValue("7d080bfa-9143-11ee-b9d1-0242ac120001"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	webhook := types.ObjectValueMust(
+		map[string]attr.Type{"type": types.StringType, "webhook": types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType}}},
+		map[string]attr.Value{
+			"type":    types.StringValue("Webhook"),
+			"webhook": types.ObjectValueMust(map[string]attr.Type{"id": types.StringType}, map[string]attr.Value{"id": types.StringValue("w1")}),
+		},
+	)
+
+	got, err := run(t, NewApprovalStepFunction(),
+		types.StringValue("and"),
+		types.DynamicValue(types.StringValue("DirectManager")),
+		types.DynamicValue(user),
+		types.DynamicValue(webhook),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step := got.(types.Object).Attributes()
+	if !step["operator"].Equal(types.StringValue("and")) || !step["sort_order"].IsNull() || !step["notified_entities"].IsNull() {
+		t.Errorf("got step %s", got)
+	}
+
+	entities := step["approval_entities"].(types.List).Elements()
+	if len(entities) != 3 {
+		t.Fatalf("got %d approval entities, want 3", len(entities))
+	}
+	for i, want := range []string{"DirectManager", "User", "Webhook"} {
+		entity := entities[i].(types.Object).Attributes()
+		if !entity["type"].Equal(types.StringValue(want)) {
+			t.Errorf("entity %d type = %s, want %q", i, entity["type"], want)
+		}
+	}
+	if v := entities[2].(types.Object).Attributes()["webhook"].(types.Object).Attributes(); !v["id"].Equal(types.StringValue("w1")) || !v["name"].IsNull() {
+		t.Errorf("got webhook %v", v)
+	}
+
+	for name, args := range map[string][]attr.Value{
+		"unknown operator": {types.StringValue("xor"), types.DynamicValue(types.StringValue("Automatic"))},
+		"no entities":      {types.StringValue("or")},
+		"unknown type":     {types.StringValue("or"), types.DynamicValue(types.StringValue("Manager"))},
+		"missing nested":   {types.StringValue("or"), types.DynamicValue(types.StringValue("User"))},
+		"wrong nested": {types.StringValue("or"), types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"type": types.StringType, "group": types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType}}},
+			map[string]attr.Value{
+				"type":  types.StringValue("User"),
+				"group": types.ObjectValueMust(map[string]attr.Type{"id": types.StringType}, map[string]attr.Value{"id": types.StringValue("g1")}),
+			},
+		))},
+		"not an entity": {types.StringValue("or"), types.DynamicValue(types.NumberValue(big.NewFloat(1)))},
+	} {
+		_, err := run(t, NewApprovalStepFunction(), args...)
+		if err == nil {
+			t.Errorf("%s: should fail", name)
+			continue
+		}
+		if strings.TrimSpace(err.Error()) == "" {
+			t.Errorf("%s: empty error", name)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GroupFunction{}
+
+// NewGroupFunction creates a new instance of the GroupFunction.
+func NewGroupFunction() function.Function {
+	return &GroupFunction{}
+}
+
+// GroupFunction defines the group function, which builds the workflow entity
+// of a directory group.
+type GroupFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f *GroupFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "group"
+}
+
+// Definition sets the definition for the function.
+func (f *GroupFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a workflow approval or notified entity for a directory group",
+		MarkdownDescription: docs.GroupFunctionMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "group",
+				MarkdownDescription: "The directory group's name, or the group's ID in UUID format.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: utils.WorkflowEntityAttributeTypes()},
+	}
+}
+
+// Run builds the group entity.
+func (f *GroupFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var group string
+	resp.Error = req.Arguments.Get(ctx, &group)
+	if resp.Error != nil {
+		return
+	}
+
+	nested := map[string]attr.Value{"id": types.StringNull(), "name": types.StringNull()}
+	switch {
+	case uuid.Validate(group) == nil:
+		nested["id"] = types.StringValue(group)
+	case strings.TrimSpace(group) != "":
+		nested["name"] = types.StringValue(group)
+	default:
+		resp.Error = function.NewArgumentFuncError(0, "expected a group name or a group ID in UUID format")
+		return
+	}
+
+	entity, err := newEntity(string(client.DirectoryGroup), nested)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, entity)
+}
@@ -0,0 +1,75 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &UserFunction{}
+
+// NewUserFunction creates a new instance of the UserFunction.
+func NewUserFunction() function.Function {
+	return &UserFunction{}
+}
+
+// UserFunction defines the user function, which builds the workflow entity of
+// a user.
+type UserFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f *UserFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user"
+}
+
+// Definition sets the definition for the function.
+func (f *UserFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a workflow approval or notified entity for a user",
+		MarkdownDescription: docs.UserFunctionMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "user",
+				MarkdownDescription: "The user's email address, or the user's ID in UUID format.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: utils.WorkflowEntityAttributeTypes()},
+	}
+}
+
+// Run builds the user entity.
+func (f *UserFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var user string
+	resp.Error = req.Arguments.Get(ctx, &user)
+	if resp.Error != nil {
+		return
+	}
+
+	nested := map[string]attr.Value{"id": types.StringNull(), "email": types.StringNull()}
+	switch {
+	case uuid.Validate(user) == nil:
+		nested["id"] = types.StringValue(user)
+	case strings.Contains(user, "@"):
+		nested["email"] = types.StringValue(user)
+	default:
+		resp.Error = function.NewArgumentFuncError(0, "expected an email address or a user ID in UUID format, got "+user)
+		return
+	}
+
+	entity, err := newEntity(string(client.EnumApprovalEntityUserUserUser), nested)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, entity)
+}
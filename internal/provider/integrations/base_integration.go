@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -19,6 +20,25 @@ import (
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
 )
 
+// integrationFieldPaths maps integration request body fields to their attributes.
+var integrationFieldPaths = utils.APIFieldPaths{
+	"name":                                 path.Root("name"),
+	"owner":                                path.Root("owner"),
+	"workflow":                             path.Root("workflow"),
+	"agentToken":                           path.Root("agent_token"),
+	"maintainers":                          path.Root("maintainers"),
+	"allowedDurations":                     path.Root("allowed_durations"),
+	"prerequisitePermissions":              path.Root("prerequisite_permissions"),
+	"readonly":                             path.Root("readonly"),
+	"requestable":                          path.Root("requestable"),
+	"requestableByDefault":                 path.Root("requestable_by_default"),
+	"allowChangingAccountPermissions":      path.Root("allow_changing_account_permissions"),
+	"allowCreatingAccounts":                path.Root("allow_creating_accounts"),
+	"autoAssignRecommendedOwners":          path.Root("auto_assign_recommended_owners"),
+	"autoAssignRecommendedMaintainers":     path.Root("auto_assign_recommended_maintainers"),
+	"notifyAboutExternalPermissionChanges": path.Root("notify_about_external_permission_changes"),
+}
+
 func CreateIntegration(
 	ctx context.Context,
 	cli *client.ClientWithResponses,
@@ -42,7 +62,11 @@ func CreateIntegration(
 		return BaseIntegrationResourceModel{}, diags
 	}
 
-	if err = utils.HTTPResponseToError(integrationResp.HTTPResponse.StatusCode, integrationResp.Body); err != nil {
+	if err = utils.ResponseToError(integrationResp.HTTPResponse, integrationResp.Body); err != nil {
+		if utils.AddAttributeErrors(&diags, err, integrationFieldPaths) {
+			return BaseIntegrationResourceModel{}, diags
+		}
+
 		diags.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -102,12 +126,16 @@ func UpdateIntegration(
 		return nil
 	}
 
-	if err = utils.HTTPResponseToError(integrationResp.HTTPResponse.StatusCode, integrationResp.Body); err != nil {
+	if err = utils.ResponseToError(integrationResp.HTTPResponse, integrationResp.Body); err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
 			resp.State.RemoveResource(ctx)
 			return nil
 		}
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, integrationFieldPaths) {
+			return nil
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -158,7 +186,7 @@ func ReadIntegration(
 		return BaseIntegrationResourceModel{}, "", false
 	}
 
-	if err = utils.HTTPResponseToError(integrationResp.HTTPResponse.StatusCode, integrationResp.Body); err != nil {
+	if err = utils.ResponseToError(integrationResp.HTTPResponse, integrationResp.Body); err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
 			resp.State.RemoveResource(ctx)
@@ -209,7 +237,7 @@ func DeleteIntegration(ctx context.Context, cli *client.ClientWithResponses, dat
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -273,6 +301,12 @@ func BuildUpdateBodyFromPlan(
 		return client.IntegrationsUpdateBodySchema{}, diags
 	}
 
+	// A nil connection JSON is omitted from the body so the API keeps the stored credentials,
+	// which is how write-only credentials are left untouched until their version changes.
+	if parsedConnectionJson != nil && *parsedConnectionJson == nil {
+		parsedConnectionJson = nil
+	}
+
 	return client.IntegrationsUpdateBodySchema{
 		AllowedDurations:                     allowedDurations,
 		AutoAssignRecommendedMaintainers:     utils.BoolPointer(data.AutoAssignRecommendedMaintainers.ValueBool()),
@@ -381,6 +415,12 @@ func ConvertBaseIntegrationResultToBaseModel(
 		return BaseIntegrationResourceModel{}, "", diags
 	}
 
+	allowedDurationLabels, advDiags := utils.GetLabelSetFromAllowedDurations(data.AllowedDurations)
+	if advDiags.HasError() {
+		diags.Append(advDiags...)
+		return BaseIntegrationResourceModel{}, "", diags
+	}
+
 	maintainers, maintainerDiags := utils.GetMaintainers(ctx, data.Maintainers)
 	if maintainerDiags.HasError() {
 		diags.Append(maintainerDiags...)
@@ -456,6 +496,7 @@ func ConvertBaseIntegrationResultToBaseModel(
 		ID:                                   utils.TrimmedStringValue(data.Id.String()),
 		Name:                                 utils.TrimmedStringValue(data.Name),
 		AllowedDurations:                     allowedDurationsValues,
+		AllowedDurationLabels:                allowedDurationLabels,
 		AllowChangingAccountPermissions:      types.BoolValue(data.AllowChangingAccountPermissions),
 		AllowCreatingAccounts:                types.BoolValue(data.AllowCreatingAccounts),
 		Readonly:                             types.BoolValue(data.Readonly),
@@ -3,7 +3,6 @@ package integrations
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -13,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
@@ -238,7 +236,7 @@ func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if data.Id.ValueString() == "" {
 		name := data.Name.ValueString()
 
-		id, err := d.getIntegrationIDByName(ctx, name)
+		id, err := utils.FindIntegrationIDByName(ctx, d.client, name)
 		if err != nil {
 			resp.Diagnostics.AddError("Integration not found", fmt.Sprintf(
 				"Failed to get the Integration by the name (%s), %s",
@@ -264,7 +262,7 @@ func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	err = utils.HTTPResponseToError(integrationResp.HTTPResponse.StatusCode, integrationResp.Body)
+	err = utils.ResponseToError(integrationResp.HTTPResponse, integrationResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -327,33 +325,3 @@ func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 }
-
-// findIntegrationByID searches the integration list for the given name.
-func (d *IntegrationDataSource) getIntegrationIDByName(ctx context.Context, name string) (*openapi_types.UUID, error) {
-	fetch := func(ctx context.Context, page int) ([]client.IntegrationBaseResponseSchema, int, error) {
-		params := client.IntegrationsIndexParams{
-			PerPage: utils.Float32Pointer(100),
-			Page:    utils.Float32Pointer(float32(page)),
-		}
-
-		resp, err := d.client.IntegrationsIndexWithResponse(ctx, &params)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list integrations: %w", err)
-		}
-
-		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
-			return nil, 0, fmt.Errorf("API returned status %d while listing integration (page %d)",
-				resp.HTTPResponse.StatusCode, page)
-		}
-
-		if resp.JSON200 == nil || resp.JSON200.Result == nil {
-			return nil, 0, fmt.Errorf("received invalid integration response structure (page %d)", page)
-		}
-
-		items := resp.JSON200.Result
-		total := int(resp.JSON200.Pagination.TotalPages)
-		return items, total, nil
-	}
-
-	return utils.FindIDByName(ctx, name, fetch)
-}
@@ -3,6 +3,9 @@ package integrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,15 +14,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IntegrationGitlabResource{}
 var _ resource.ResourceWithImportState = &IntegrationGitlabResource{}
+var _ resource.ResourceWithIdentity = &IntegrationGitlabResource{}
+var _ resource.ResourceWithModifyPlan = &IntegrationGitlabResource{}
 
 const GitlabDefaultDomain = "https://gitlab.com"
 
@@ -33,10 +41,12 @@ type IntegrationGitlabResource struct {
 }
 
 type GitlabConnectionModel struct {
-	Domain       types.String `tfsdk:"domain"`
-	PrivateToken types.String `tfsdk:"private_token"`
-	SSLVerify    types.Bool   `tfsdk:"ssl_verify"`
-	SSLCaCert    types.String `tfsdk:"ssl_ca_cert"`
+	Domain                types.String `tfsdk:"domain"`
+	PrivateToken          types.String `tfsdk:"private_token"`
+	PrivateTokenWO        types.String `tfsdk:"private_token_wo"`
+	PrivateTokenWOVersion types.Int64  `tfsdk:"private_token_wo_version"`
+	SSLVerify             types.Bool   `tfsdk:"ssl_verify"`
+	SSLCaCert             types.String `tfsdk:"ssl_ca_cert"`
 }
 
 // IntegrationGitlabResourceModel describes the resource data model.
@@ -70,9 +80,28 @@ func (r *IntegrationGitlabResource) Schema(ctx context.Context, req resource.Sch
 					},
 					"private_token": schema.StringAttribute{
 						Description: "A GitLab Personal Access Token with the `api` scope. " +
-							"Create one in GitLab under Edit Profile → Access Tokens.",
-						Required:  true,
+							"Create one in GitLab under Edit Profile → Access Tokens. " +
+							"Exactly one of private_token or private_token_wo must be set.",
+						Optional:  true,
 						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("private_token_wo")),
+						},
+					},
+					"private_token_wo": schema.StringAttribute{
+						Description: "Write-only variant of private_token, never stored in the plan or state. " +
+							"Requires Terraform 1.11 or later. Increment private_token_wo_version to rotate the token.",
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+					},
+					"private_token_wo_version": schema.Int64Attribute{
+						Description: "Version of private_token_wo. Terraform cannot detect changes to a write-only value, " +
+							"so change this number whenever the token is rotated to trigger an update.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("private_token_wo")),
+						},
 					},
 					"ssl_verify": schema.BoolAttribute{
 						Description: "Whether to verify the GitLab server's SSL certificate. " +
@@ -105,6 +134,14 @@ func (r *IntegrationGitlabResource) Configure(_ context.Context, req resource.Co
 	configureIntegrationResource(req.ProviderData, &r.client, &resp.Diagnostics)
 }
 
+// ModifyPlan resolves the owner and maintainers configured by email to their
+// IDs, so they are planned and stored in state along with the email, and
+// warns about the maintainers the plan removes.
+func (r *IntegrationGitlabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	utils.ResolveOwnerAndMaintainers(ctx, r.client, req, resp)
+	utils.WarnMaintainersRemoved(ctx, req, resp, "entitle_integration_maintainer")
+}
+
 // Create this function is responsible for creating a new resource of type Entitle Integration.
 //
 // Its reads the Terraform plan data provided in req.Plan and maps it to the IntegrationGitlabResourceModel.
@@ -118,6 +155,11 @@ func (r *IntegrationGitlabResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	resp.Diagnostics.Append(gitlabPrivateTokenFromConfig(ctx, plan.Connection, req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	parsedConnectionJson := parseGitlabConnectionJson(plan.Connection)
 
 	newBase, diags := CreateIntegration(ctx, r.client, plan.BaseIntegrationResourceModel, applicationGitlab, parsedConnectionJson)
@@ -126,10 +168,13 @@ func (r *IntegrationGitlabResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	plan.Connection.PrivateTokenWO = types.StringNull()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, IntegrationGitlabResourceModel{
 		BaseIntegrationResourceModel: newBase,
 		Connection:                   plan.Connection,
 	})...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read this function is used to read an existing resource of type Entitle Integration.
@@ -154,6 +199,7 @@ func (r *IntegrationGitlabResource) Read(ctx context.Context, req resource.ReadR
 		BaseIntegrationResourceModel: newBase,
 		Connection:                   data.Connection,
 	})...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update this function handles updates to an existing resource of type Entitle Integration.
@@ -169,16 +215,35 @@ func (r *IntegrationGitlabResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	resp.Diagnostics.Append(gitlabPrivateTokenFromConfig(ctx, data.Connection, req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	parsedConnectionJson := parseGitlabConnectionJson(data.Connection)
 	newBase := UpdateIntegration(ctx, r.client, data.BaseIntegrationResourceModel, applicationGitlab, parsedConnectionJson, resp)
 	if newBase == nil {
 		return
 	}
 
+	data.Connection.PrivateTokenWO = types.StringNull()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, IntegrationGitlabResourceModel{
 		BaseIntegrationResourceModel: *newBase,
 		Connection:                   data.Connection,
 	})...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// gitlabPrivateTokenFromConfig fills PrivateTokenWO from the configuration when private_token is
+// not set. Write-only values are never present in the plan, and the whole connection is sent on
+// every update, so the token is always taken from the configuration.
+func gitlabPrivateTokenFromConfig(ctx context.Context, m *GitlabConnectionModel, config tfsdk.Config) diag.Diagnostics {
+	if m == nil || !m.PrivateToken.IsNull() {
+		return nil
+	}
+
+	return config.GetAttribute(ctx, path.Root("connection_data").AtName("private_token_wo"), &m.PrivateTokenWO)
 }
 
 func parseGitlabConnectionJson(m *GitlabConnectionModel) map[string]interface{} {
@@ -195,10 +260,15 @@ func parseGitlabConnectionJson(m *GitlabConnectionModel) map[string]interface{}
 		ssl["ca_cert"] = caCert
 	}
 
+	privateToken := connectionModel.PrivateToken
+	if privateToken.IsNull() {
+		privateToken = connectionModel.PrivateTokenWO
+	}
+
 	jsonSchema := map[string]interface{}{
 		"configurationSchemaName": "Configuration ",
 		"domain":                  connectionModel.Domain.ValueString(),
-		"private_token":           connectionModel.PrivateToken.ValueString(),
+		"private_token":           privateToken.ValueString(),
 		"options": map[string]interface{}{
 			"ssl": ssl,
 		},
@@ -225,10 +295,15 @@ func (r *IntegrationGitlabResource) Delete(ctx context.Context, req resource.Del
 	DeleteIntegration(ctx, r.client, data.BaseIntegrationResourceModel, resp)
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *IntegrationGitlabResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
 // It extracts the resource's identifier from the import request and sets
 // it in Terraform state using resource.ImportStatePassthroughID.
 func (r *IntegrationGitlabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
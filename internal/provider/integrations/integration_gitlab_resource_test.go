@@ -10,6 +10,7 @@ import (
 
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/integrations"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
 )
@@ -189,3 +190,53 @@ resource "entitle_integration_gitlab" "my_gitlab" {
 		},
 	})
 }
+
+func testIntegrationGitlabResourceWriteOnlyConfig(version int) string {
+	return testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_integration_gitlab" "my_gitlab_wo" {
+  	name                               = "My Gitlab Write-Only Integration"
+	allowed_durations = [-1]
+    allow_creating_accounts           = false
+    owner = {
+      id    = "%s"
+    }
+    workflow = {
+      id   = "%s"
+    }
+    connection_data = {
+		private_token_wo         = "%s"
+		private_token_wo_version = %d
+  	}
+}
+`, os.Getenv("ENTITLE_OWNER_ID"), os.Getenv("ENTITLE_WORKFLOW_ID"), os.Getenv("GITLAB_ACCESS_TOKEN"), version)
+}
+
+func TestIntegrationGitlabResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testIntegrationGitlabResourceWriteOnlyConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_integration_gitlab.my_gitlab_wo", "connection_data.domain", integrations.GitlabDefaultDomain),
+					resource.TestCheckResourceAttr("entitle_integration_gitlab.my_gitlab_wo", "connection_data.private_token_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("entitle_integration_gitlab.my_gitlab_wo", "connection_data.private_token"),
+					resource.TestCheckNoResourceAttr("entitle_integration_gitlab.my_gitlab_wo", "connection_data.private_token_wo"),
+					resource.TestCheckResourceAttrSet("entitle_integration_gitlab.my_gitlab_wo", "id"),
+				),
+			},
+			// Rotation testing
+			{
+				Config: testIntegrationGitlabResourceWriteOnlyConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_integration_gitlab.my_gitlab_wo", "connection_data.private_token_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("entitle_integration_gitlab.my_gitlab_wo", "connection_data.private_token_wo"),
+				),
+			},
+		},
+	})
+}
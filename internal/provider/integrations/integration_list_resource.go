@@ -0,0 +1,116 @@
+package integrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &IntegrationListResource{}
+var _ list.ListResourceWithConfigure = &IntegrationListResource{}
+
+// NewIntegrationListResource creates a new instance of the IntegrationListResource.
+func NewIntegrationListResource() list.ListResource {
+	return &IntegrationListResource{}
+}
+
+// IntegrationListResource lists integrations for Terraform query.
+type IntegrationListResource struct {
+	client *client.ClientWithResponses
+}
+
+// IntegrationListResourceModel describes the list resource configuration.
+type IntegrationListResourceModel struct {
+	Search types.String `tfsdk:"search"`
+}
+
+// Metadata sets the metadata for the list resource, which lists entitle_integration resources.
+func (r *IntegrationListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *IntegrationListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.IntegrationListResourceMarkdownDescription,
+		Attributes: map[string]listschema.Attribute{
+			"search": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Search string to filter integrations by name.",
+			},
+		},
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *IntegrationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each integration matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *IntegrationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data IntegrationListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, utils.IDIdentityPaths,
+		func(ctx context.Context, page int) ([]client.IntegrationBaseResponseSchema, int, error) {
+			apiResp, err := r.client.IntegrationsIndexWithResponse(ctx, &client.IntegrationsIndexParams{
+				Page:    utils.Float32Pointer(float32(page)),
+				PerPage: utils.Float32Pointer(100),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			items := make([]client.IntegrationBaseResponseSchema, 0, len(apiResp.JSON200.Result))
+			for _, item := range apiResp.JSON200.Result {
+				if utils.MatchesSearch(data.Search.ValueStringPointer(), item.Name) {
+					items = append(items, item)
+				}
+			}
+
+			return items, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, i client.IntegrationBaseResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), i.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("name"), i.Name)...)
+			diags.Append(res.SetAttribute(ctx, path.Root("application").AtName("name"), i.Application.Name)...)
+			return i.Name, diags
+		},
+	)
+}
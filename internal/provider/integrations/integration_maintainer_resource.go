@@ -0,0 +1,313 @@
+package integrations
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationMaintainerResource{}
+var _ resource.ResourceWithImportState = &IntegrationMaintainerResource{}
+var _ resource.ResourceWithIdentity = &IntegrationMaintainerResource{}
+var _ resource.ResourceWithModifyPlan = &IntegrationMaintainerResource{}
+
+// integrationMaintainerImportFormat is the format of the id and import ID of
+// an entitle_integration_maintainer.
+const integrationMaintainerImportFormat = "<integration_id>/<entity_id>"
+
+// integrationMaintainerIdentityPaths maps the identity attributes to their state
+// attributes.
+var integrationMaintainerIdentityPaths = utils.IdentityPaths{
+	"integration_id": path.Root("integration_id"),
+	"entity_id":      path.Root("entity").AtName("id"),
+}
+
+// NewIntegrationMaintainerResource creates a new instance of the IntegrationMaintainerResource.
+func NewIntegrationMaintainerResource() resource.Resource {
+	return &IntegrationMaintainerResource{}
+}
+
+// IntegrationMaintainerResource defines the resource implementation. It adds a
+// single maintainer to an integration, keeping its other maintainers.
+type IntegrationMaintainerResource struct {
+	client *client.ClientWithResponses
+}
+
+// IntegrationMaintainerResourceModel describes the resource data model.
+type IntegrationMaintainerResourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	IntegrationID types.String        `tfsdk:"integration_id"`
+	Type          types.String        `tfsdk:"type"`
+	Entity        *utils.IdEmailModel `tfsdk:"entity"`
+}
+
+// Metadata sets the metadata for the resource.
+func (r *IntegrationMaintainerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_maintainer"
+}
+
+// Schema sets the schema for the resource.
+func (r *IntegrationMaintainerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.IntegrationMaintainerResourceMarkdownDescription,
+		Description:         "Adds a single user or group maintainer to an integration, keeping its other maintainers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the attachment, in the format " + integrationMaintainerImportFormat + ".",
+				MarkdownDescription: "Identifier of the attachment, in the format `" + integrationMaintainerImportFormat + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The id of the integration to add the maintainer to.",
+				MarkdownDescription: "The id of the integration to add the maintainer to.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type":   utils.MaintainerAttachmentTypeAttribute(),
+			"entity": utils.MaintainerAttachmentEntityAttribute(),
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *IntegrationMaintainerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureIntegrationResource(req.ProviderData, &r.client, &resp.Diagnostics)
+}
+
+// ModifyPlan resolves the maintainer configured by email to its ID.
+func (r *IntegrationMaintainerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	utils.ResolveMaintainerEntity(ctx, r.client, req, resp)
+}
+
+// Create adds the maintainer to the integration.
+func (r *IntegrationMaintainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IntegrationMaintainerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationID, err := uuid.Parse(plan.IntegrationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to parse the integration id to UUID: %v", err))
+		return
+	}
+
+	unlock := utils.LockObject(utils.ObjectKindIntegration, integrationID)
+	defer unlock()
+
+	maintainer := utils.Maintainer{Type: plan.Type.ValueString(), ID: plan.Entity.Id.ValueString()}
+	err = utils.UpdateMaintainer(ctx, r.maintainers(integrationID), maintainer, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to add the maintainer %s to the integration (%s): %s", maintainer.ID, integrationID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(integrationID.String() + "/" + maintainer.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, integrationMaintainerIdentityPaths)...)
+}
+
+// Read checks the maintainer is still one of the integration's. When it is
+// not, it is removed from state, so the next apply adds it again.
+func (r *IntegrationMaintainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IntegrationMaintainerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationID, err := uuid.Parse(data.IntegrationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to parse the integration id to UUID: %v", err))
+		return
+	}
+
+	maintainers, err := r.maintainers(integrationID).Get(ctx)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to get the maintainers of the integration (%s): %s", integrationID, err),
+		)
+		return
+	}
+
+	// An import gives the entity id only; the type is the maintainer's.
+	if data.Type.IsNull() {
+		for _, m := range maintainers {
+			if strings.EqualFold(m.ID, data.Entity.Id.ValueString()) {
+				data.Type = types.StringValue(m.Type)
+			}
+		}
+	}
+
+	maintainer := utils.Maintainer{Type: data.Type.ValueString(), ID: data.Entity.Id.ValueString()}
+	if !utils.HasMaintainer(maintainers, maintainer) {
+		resp.Diagnostics.AddWarning(
+			"Maintainer Removed",
+			fmt.Sprintf("The maintainer %s is no longer a maintainer of the integration (%s) and will be added again. "+
+				"If the maintainers attribute of its entitle_integration is set, it removes this maintainer on every apply.",
+				maintainer.ID, integrationID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, integrationMaintainerIdentityPaths)...)
+}
+
+// Update stores the plan; every change of the maintainer replaces it.
+func (r *IntegrationMaintainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IntegrationMaintainerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, integrationMaintainerIdentityPaths)...)
+}
+
+// Delete removes the maintainer from the integration, keeping its other
+// maintainers.
+func (r *IntegrationMaintainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IntegrationMaintainerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationID, err := uuid.Parse(data.IntegrationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to parse the integration id to UUID: %v", err))
+		return
+	}
+
+	unlock := utils.LockObject(utils.ObjectKindIntegration, integrationID)
+	defer unlock()
+
+	maintainer := utils.Maintainer{Type: data.Type.ValueString(), ID: data.Entity.Id.ValueString()}
+	err = utils.UpdateMaintainer(ctx, r.maintainers(integrationID), maintainer, false)
+	if err != nil && !errors.Is(err, utils.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to remove the maintainer %s from the integration (%s): %s", maintainer.ID, integrationID, err),
+		)
+	}
+}
+
+// IdentitySchema defines the identity of the maintainer of an integration:
+// its integration's and maintainer's IDs.
+func (r *IntegrationMaintainerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"integration_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the integration.",
+			},
+			"entity_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the maintainer user or group.",
+			},
+		},
+	}
+}
+
+// ImportState imports the maintainer of an integration by an ID in the format
+// <integration_id>/<entity_id>, or by its identity.
+func (r *IntegrationMaintainerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = utils.IdentityImportID(ctx, req.Identity, "integration_id", "entity_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ids, err := utils.SplitImportID(importID, integrationMaintainerImportFormat)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &IntegrationMaintainerResourceModel{
+		ID:            types.StringValue(ids[0].String() + "/" + ids[1].String()),
+		IntegrationID: types.StringValue(ids[0].String()),
+		Type:          types.StringNull(),
+		Entity: &utils.IdEmailModel{
+			Id:    types.StringValue(ids[1].String()),
+			Email: types.StringNull(),
+		},
+	})...)
+}
+
+// maintainers reads and writes the maintainers of an integration. Writes send
+// the maintainers only, leaving the other settings of the integration as they
+// are.
+func (r *IntegrationMaintainerResource) maintainers(integrationID uuid.UUID) utils.MaintainerAccess {
+	return utils.MaintainerAccess{
+		Get: func(ctx context.Context) ([]utils.Maintainer, error) {
+			apiResp, err := r.client.IntegrationsShowWithResponse(ctx, integrationID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, err
+			}
+
+			return utils.ParseMaintainers(apiResp.JSON200.Result.Maintainers)
+		},
+		Set: func(ctx context.Context, maintainers []utils.Maintainer) error {
+			body, err := utils.MaintainersUpdateBody(maintainers)
+			if err != nil {
+				return err
+			}
+
+			apiResp, err := r.client.IntegrationsUpdateWithBodyWithResponse(ctx, integrationID, "application/json", bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+
+			return utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package integrations_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestIntegrationMaintainerResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	maintainer := srv.AddUser("maintainer@example.com")
+	group := srv.AddGroup("engineering", "engineering@example.com")
+
+	// The integration already has a maintainer that the attachment must keep.
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "My Manual Integration",
+		"application": map[string]any{"name": "manual"},
+		"maintainers": []any{map[string]any{"type": "user", "user": map[string]any{"id": maintainer.ID}}},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             checkIntegrationMaintainers(srv, integrationID, maintainer.ID),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_integration_maintainer" "my_maintainer" {
+	integration_id = "%s"
+	type           = "group"
+	entity = {
+		id = "%s"
+	}
+}
+`, integrationID, group.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_integration_maintainer.my_maintainer", "id", integrationID+"/"+group.ID),
+					checkIntegrationMaintainers(srv, integrationID, maintainer.ID, group.ID),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("entitle_integration_maintainer.my_maintainer", map[string]knownvalue.Check{
+						"integration_id": knownvalue.StringExact(integrationID),
+						"entity_id":      knownvalue.StringExact(group.ID),
+					}),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_integration_maintainer.my_maintainer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// checkIntegrationMaintainers checks the IDs of the maintainers of an
+// integration in the fake are ids, in any order.
+func checkIntegrationMaintainers(srv *fakeentitle.Server, integrationID string, ids ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		integration, ok := srv.Get(fakeentitle.Integrations, integrationID)
+		if !ok {
+			return fmt.Errorf("integration %s not found", integrationID)
+		}
+
+		var got []string
+		maintainers, _ := integration["maintainers"].([]any)
+		for _, m := range maintainers {
+			m := m.(map[string]any)
+			entity, _ := m[fmt.Sprint(m["type"])].(map[string]any)
+			got = append(got, fmt.Sprint(entity["id"]))
+		}
+
+		slices.Sort(got)
+		want := slices.Sorted(slices.Values(ids))
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected integration %s maintainers to be %v, got %v", integrationID, want, got)
+		}
+
+		return nil
+	}
+}
@@ -9,19 +9,24 @@ import (
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
 	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IntegrationResource{}
 var _ resource.ResourceWithImportState = &IntegrationResource{}
+var _ resource.ResourceWithIdentity = &IntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &IntegrationResource{}
 
 func NewIntegrationResource() resource.Resource {
 	return &IntegrationResource{}
@@ -35,8 +40,10 @@ type IntegrationResource struct {
 // IntegrationResourceModel describes the resource data model.
 type IntegrationResourceModel struct {
 	BaseIntegrationResourceModel
-	ConnectionJson types.String     `tfsdk:"connection_json"`
-	Application    *utils.NameModel `tfsdk:"application"`
+	ConnectionJson          types.String     `tfsdk:"connection_json"`
+	ConnectionJsonWO        types.String     `tfsdk:"connection_json_wo"`
+	ConnectionJsonWOVersion types.Int64      `tfsdk:"connection_json_wo_version"`
+	Application             *utils.NameModel `tfsdk:"application"`
 }
 
 func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,9 +57,35 @@ func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaReq
 			m := maps.Clone(BaseIntegrationResourceAttributes)
 
 			m["connection_json"] = schema.StringAttribute{
-				Required:            true,
-				Description:         "You can get it on [this page](https://docs.beyondtrust.com/entitle/docs/integrations) or using [web ui create form](https://app.entitle.io/integrations/create).",
-				MarkdownDescription: "You can get it on [this page](https://docs.beyondtrust.com/entitle/docs/integrations) or using [web ui create form](https://app.entitle.io/integrations/create).",
+				Optional: true,
+				Description: "You can get it on [this page](https://docs.beyondtrust.com/entitle/docs/integrations) or using [web ui create form](https://app.entitle.io/integrations/create). " +
+					"Exactly one of connection_json or connection_json_wo must be set.",
+				MarkdownDescription: "You can get it on [this page](https://docs.beyondtrust.com/entitle/docs/integrations) or using [web ui create form](https://app.entitle.io/integrations/create). " +
+					"Exactly one of `connection_json` or `connection_json_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("connection_json_wo")),
+				},
+			}
+
+			m["connection_json_wo"] = schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "Write-only variant of connection_json, never stored in the plan or state. " +
+					"Requires Terraform 1.11 or later. Increment connection_json_wo_version to send a new value.",
+				MarkdownDescription: "Write-only variant of `connection_json`, never stored in the plan or state. " +
+					"Requires Terraform 1.11 or later. Increment `connection_json_wo_version` to send a new value.",
+			}
+
+			m["connection_json_wo_version"] = schema.Int64Attribute{
+				Optional: true,
+				Description: "Version of connection_json_wo. Terraform cannot detect changes to a write-only value, " +
+					"so change this number whenever the credentials are rotated to trigger an update.",
+				MarkdownDescription: "Version of `connection_json_wo`. Terraform cannot detect changes to a write-only value, " +
+					"so change this number whenever the credentials are rotated to trigger an update.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("connection_json_wo")),
+				},
 			}
 
 			m["application"] = schema.SingleNestedAttribute{
@@ -86,6 +119,14 @@ func (r *IntegrationResource) Configure(_ context.Context, req resource.Configur
 	configureIntegrationResource(req.ProviderData, &r.client, &resp.Diagnostics)
 }
 
+// ModifyPlan resolves the owner and maintainers configured by email to their
+// IDs, so they are planned and stored in state along with the email, and
+// warns about the maintainers the plan removes.
+func (r *IntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	utils.ResolveOwnerAndMaintainers(ctx, r.client, req, resp)
+	utils.WarnMaintainersRemoved(ctx, req, resp, "entitle_integration_maintainer")
+}
+
 // Create this function is responsible for creating a new resource of type Entitle Integration.
 //
 // Its reads the Terraform plan data provided in req.Plan and maps it to the IntegrationResourceModel.
@@ -99,7 +140,7 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	parsedConnectionJson, diags := ParseConnectionJson(plan.ConnectionJson.ValueString())
+	parsedConnectionJson, diags := connectionJsonFromConfig(ctx, plan, req.Config)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -114,10 +155,12 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, IntegrationResourceModel{
 		BaseIntegrationResourceModel: newBase,
 		ConnectionJson:               plan.ConnectionJson,
+		ConnectionJsonWOVersion:      plan.ConnectionJsonWOVersion,
 		Application: &utils.NameModel{
 			Name: utils.TrimmedStringValue(strings.ToLower(plan.Application.Name.ValueString())),
 		},
 	})...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read this function is used to read an existing resource of type Entitle Integration.
@@ -141,10 +184,12 @@ func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, IntegrationResourceModel{
 		BaseIntegrationResourceModel: newBase,
 		ConnectionJson:               data.ConnectionJson,
+		ConnectionJsonWOVersion:      data.ConnectionJsonWOVersion,
 		Application: &utils.NameModel{
 			Name: utils.TrimmedStringValue(strings.ToLower(appName)),
 		},
 	})...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update this function handles updates to an existing resource of type Entitle Integration.
@@ -160,12 +205,26 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	parsedConnectionJson, diags := ParseConnectionJson(data.ConnectionJson.ValueString())
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	var state IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// A write-only connection JSON is only sent again when its version changes,
+	// so unrelated updates keep the credentials already stored in Entitle.
+	var parsedConnectionJson map[string]interface{}
+	if !data.ConnectionJson.IsNull() || !state.ConnectionJson.IsNull() ||
+		!data.ConnectionJsonWOVersion.Equal(state.ConnectionJsonWOVersion) {
+		var diags diag.Diagnostics
+		parsedConnectionJson, diags = connectionJsonFromConfig(ctx, data, req.Config)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	newBase := UpdateIntegration(ctx, r.client, data.BaseIntegrationResourceModel, applicationName(data.Application.Name.ValueString()), parsedConnectionJson, resp)
 	if newBase == nil {
 		return
@@ -174,10 +233,28 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, IntegrationResourceModel{
 		BaseIntegrationResourceModel: *newBase,
 		ConnectionJson:               data.ConnectionJson,
+		ConnectionJsonWOVersion:      data.ConnectionJsonWOVersion,
 		Application: &utils.NameModel{
 			Name: utils.TrimmedStringValue(strings.ToLower(data.Application.Name.ValueString())),
 		},
 	})...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// connectionJsonFromConfig parses the connection JSON of the integration. Write-only values are
+// never present in the plan, so connection_json_wo is read from the configuration instead.
+func connectionJsonFromConfig(ctx context.Context, data IntegrationResourceModel, config tfsdk.Config) (map[string]interface{}, diag.Diagnostics) {
+	if !data.ConnectionJson.IsNull() {
+		return ParseConnectionJson(data.ConnectionJson.ValueString())
+	}
+
+	var connectionJsonWO types.String
+	diags := config.GetAttribute(ctx, path.Root("connection_json_wo"), &connectionJsonWO)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ParseConnectionJson(connectionJsonWO.ValueString())
 }
 
 // Delete this function is responsible for deleting an existing resource of type
@@ -198,10 +275,15 @@ func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteReq
 	DeleteIntegration(ctx, r.client, data.BaseIntegrationResourceModel, resp)
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *IntegrationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
 // It extracts the resource's identifier from the import request and sets
 // it in Terraform state using resource.ImportStatePassthroughID.
 func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
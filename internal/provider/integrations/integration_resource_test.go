@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
 )
@@ -501,3 +502,58 @@ resource "entitle_integration" "test_integration" {
 		},
 	})
 }
+
+func testIntegrationResourceWriteOnlyConfig(version int) string {
+	return testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_integration" "my_gitlab_wo" {
+  	name                               = "My Gitlab Write-Only Integration"
+    application = {
+   	  name = "gitlab"
+    }
+	allowed_durations = [-1]
+    allow_creating_accounts           = false
+    connection_json_wo                       = jsonencode({
+		domain                   = "https://gitlab.com"
+		private_token            = "%s"
+		configurationSchemaName = "Configuration "
+	  })
+    connection_json_wo_version = %d
+    owner = {
+      id    = "%s"
+    }
+    workflow = {
+      id   = "%s"
+    }
+}
+`, os.Getenv("GITLAB_ACCESS_TOKEN"), version, os.Getenv("ENTITLE_OWNER_ID"), os.Getenv("ENTITLE_WORKFLOW_ID"))
+}
+
+func TestIntegrationResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testIntegrationResourceWriteOnlyConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_integration.my_gitlab_wo", "name", "My Gitlab Write-Only Integration"),
+					resource.TestCheckResourceAttr("entitle_integration.my_gitlab_wo", "connection_json_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("entitle_integration.my_gitlab_wo", "connection_json"),
+					resource.TestCheckNoResourceAttr("entitle_integration.my_gitlab_wo", "connection_json_wo"),
+					resource.TestCheckResourceAttrSet("entitle_integration.my_gitlab_wo", "id"),
+				),
+			},
+			// Rotation testing
+			{
+				Config: testIntegrationResourceWriteOnlyConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_integration.my_gitlab_wo", "connection_json_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("entitle_integration.my_gitlab_wo", "connection_json_wo"),
+				),
+			},
+		},
+	})
+}
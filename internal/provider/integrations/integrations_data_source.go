@@ -0,0 +1,171 @@
+package integrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure the data source satisfies the framework interface.
+var _ datasource.DataSource = &IntegrationsDataSource{}
+
+// IntegrationsDataSource defines the list data source implementation.
+type IntegrationsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// NewIntegrationsDataSource creates a new instance of IntegrationsDataSource.
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &IntegrationsDataSource{}
+}
+
+// IntegrationsDataSourceModel describes the data source model.
+type IntegrationsDataSourceModel struct {
+	AllPages     types.Bool                       `tfsdk:"all_pages"`
+	Filter       *utils.PaginationWithSearchModel `tfsdk:"filter"`
+	Integrations []IntegrationListItem            `tfsdk:"integrations"`
+}
+
+// IntegrationListItem represents a single integration in the list.
+type IntegrationListItem struct {
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Application *utils.NameModel `tfsdk:"application"`
+}
+
+// Metadata sets the metadata for the data source.
+func (d *IntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+// Schema defines the schema for the data source.
+func (d *IntegrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.IntegrationsDataSourceMarkdownDescription,
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Pagination and filter integrations by optional search term.",
+				Attributes: map[string]schema.Attribute{
+					"search": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Search string to filter integrations by name or application name. The search lists every page, and `page` and `per_page` then select a page of the matches.",
+					},
+					"page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Page number of results to return (starting from 1). Used together with `per_page` for pagination.",
+					},
+					"per_page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of results to return per page. Defaults to the API's configured page size if not specified.",
+					},
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"all_pages": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Walk every page of results instead of a single page. Cannot be combined with `filter.page`.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("filter").AtName("page")),
+				},
+			},
+			"integrations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of integrations matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   schema.StringAttribute{Computed: true},
+						"name": schema.StringAttribute{Computed: true},
+						"application": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Computed: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the client used by the data source.
+func (d *IntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+// Read retrieves integrations from the Entitle API using filters.
+func (d *IntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := utils.ListSearchedPages(ctx, data.Filter, data.AllPages.ValueBool(),
+		func(ctx context.Context, _ *string, page, perPage *int) ([]client.IntegrationBaseResponseSchema, int, error) {
+			apiResp, err := d.client.IntegrationsIndexWithResponse(ctx, &client.IntegrationsIndexParams{
+				Page:    utils.Float32PointerFromInt(page),
+				PerPage: utils.Float32PointerFromInt(perPage),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(item client.IntegrationBaseResponseSchema) []string {
+			return []string{item.Name, item.Application.Name}
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to list integrations: %s", err))
+		return
+	}
+
+	integrations := make([]IntegrationListItem, len(results))
+	for i, in := range results {
+		integrations[i] = IntegrationListItem{
+			ID:   types.StringValue(in.Id.String()),
+			Name: types.StringValue(in.Name),
+			Application: &utils.NameModel{
+				Name: utils.TrimmedStringValue(in.Application.Name),
+			},
+		}
+	}
+
+	data.Integrations = integrations
+	tflog.Trace(ctx, "Read entitle integrations list data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
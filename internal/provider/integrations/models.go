@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Constants for default values of integration settings.
@@ -73,6 +75,7 @@ type BaseIntegrationResourceModel struct {
 	ID                                   types.String                        `tfsdk:"id"`
 	Name                                 types.String                        `tfsdk:"name"`
 	AllowedDurations                     types.Set                           `tfsdk:"allowed_durations"`
+	AllowedDurationLabels                types.Set                           `tfsdk:"allowed_duration_labels"`
 	AllowChangingAccountPermissions      types.Bool                          `tfsdk:"allow_changing_account_permissions"`
 	AllowCreatingAccounts                types.Bool                          `tfsdk:"allow_creating_accounts"`
 	Readonly                             types.Bool                          `tfsdk:"readonly"`
@@ -106,14 +109,22 @@ var BaseIntegrationResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"allowed_durations": schema.SetAttribute{
-		Required:            true,
 		ElementType:         types.NumberType,
-		Description:         "As the admin, you can set different durations for the integration, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
-		MarkdownDescription: "As the admin, you can set different durations for the integration, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
+		Optional:            true,
+		Computed:            true,
+		Description:         "As the admin, you can set different durations for the integration, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nExactly one of allowed_durations and allowed_duration_labels must be set.",
+		MarkdownDescription: "As the admin, you can set different durations for the integration, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nExactly one of `allowed_durations` and `allowed_duration_labels` must be set.",
 		Validators: []validator.Set{
 			setvalidator.SizeAtLeast(1),
+			setvalidator.ExactlyOneOf(path.MatchRoot("allowed_duration_labels")),
+			validators.AllowedDurations{},
+		},
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+			utils.AllowedDurationsFromLabels(),
 		},
 	},
+	"allowed_duration_labels": utils.AllowedDurationLabelsAttribute(),
 	"maintainers": schema.SetNestedAttribute{
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
@@ -125,14 +136,23 @@ var BaseIntegrationResourceAttributes = map[string]schema.Attribute{
 				"entity": schema.SingleNestedAttribute{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
-							Required:            true,
+							Optional:            true,
+							Computed:            true,
 							Description:         "Maintainer's unique identifier",
 							MarkdownDescription: "Maintainer's unique identifier",
+							Validators: []validator.String{
+								stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+							},
 						},
 						"email": schema.StringAttribute{
+							Optional:            true,
 							Computed:            true,
-							Description:         "Maintainer's email",
-							MarkdownDescription: "Maintainer's email",
+							Description:         "Maintainer's email (lowercase), resolved to the user's or group's id at plan time when id is not set",
+							MarkdownDescription: "Maintainer's email (lowercase), resolved to the user's or group's `id` at plan time when `id` is not set",
+							Validators: []validator.String{
+								validators.Email{},
+								validators.Lowercase{},
+							},
 						},
 					},
 					Optional:            true,
@@ -342,14 +362,26 @@ var BaseIntegrationResourceAttributes = map[string]schema.Attribute{
 	"owner": schema.SingleNestedAttribute{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				Description:         "the owner's id",
 				MarkdownDescription: "the owner's id",
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"email": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "the owner's email",
-				MarkdownDescription: "the owner's email",
+				Description:         "the owner's email (lowercase), resolved to the owner's id at plan time when id is not set",
+				MarkdownDescription: "the owner's email (lowercase), resolved to the owner's `id` at plan time when `id` is not set",
+				Validators: []validator.String{
+					validators.Email{},
+					validators.Lowercase{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -22,6 +22,7 @@ import (
 // Ensure the interface is satisfied.
 var _ resource.Resource = &PermissionResource{}
 var _ resource.ResourceWithImportState = &PermissionResource{}
+var _ resource.ResourceWithIdentity = &PermissionResource{}
 
 type PermissionResource struct {
 	client *client.ClientWithResponses
@@ -121,7 +122,7 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	perm, err := r.findPermissionByID(ctx, plan.ID.ValueString(), 1)
+	perm, err := r.findPermissionByID(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Permission Not Found", err.Error())
 		return
@@ -134,6 +135,7 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read fetches the permission from the list and updates state.
@@ -144,7 +146,7 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	perm, err := r.findPermissionByID(ctx, state.ID.ValueString(), 1)
+	perm, err := r.findPermissionByID(ctx, state.ID.ValueString())
 	if err != nil {
 		// Permission no longer exists → remove from state
 		resp.State.RemoveResource(ctx)
@@ -158,6 +160,7 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update is not supported.
@@ -175,6 +178,7 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Delete removes the permission via API.
@@ -194,43 +198,52 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	if err := utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body, utils.WithIgnorePending(), utils.WithIgnoreNotFound()); err != nil {
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body, utils.WithIgnorePending(), utils.WithIgnoreNotFound()); err != nil {
 		resp.Diagnostics.AddError(utils.ErrApiResponse.Error(), fmt.Sprintf("Failed to delete permission: %s", err))
 		return
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *PermissionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState allows terraform import.
 func (r *PermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // --- Helper functions ---
 
 // findPermissionByID searches the permission list for the given ID.
-func (r *PermissionResource) findPermissionByID(ctx context.Context, id string, page int) (*client.PermissionSchema, error) {
-	params := client.PermissionsIndexParams{
-		PerPage: utils.Float32Pointer(1000),
-		Page:    utils.Float32Pointer(float32(page)),
-	}
-	permissionsResp, err := r.client.PermissionsIndexWithResponse(ctx, &params)
-	if err != nil {
-		return nil, fmt.Errorf("unable to list permissions: %w", err)
-	}
-	if permissionsResp.JSON200 == nil || permissionsResp.JSON200.Result == nil {
-		return nil, fmt.Errorf("no permissions found")
+func (r *PermissionResource) findPermissionByID(ctx context.Context, id string) (*client.PermissionSchema, error) {
+	fetch := func(ctx context.Context, page int) ([]client.PermissionSchema, int, error) {
+		params := client.PermissionsIndexParams{
+			PerPage: utils.Float32Pointer(1000),
+			Page:    utils.Float32Pointer(float32(page)),
+		}
+		permissionsResp, err := r.client.PermissionsIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to list permissions: %w", err)
+		}
+		if permissionsResp.JSON200 == nil || permissionsResp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("no permissions found")
+		}
+
+		return permissionsResp.JSON200.Result, int(permissionsResp.JSON200.Pagination.TotalPages), nil
 	}
 
-	for _, perm := range permissionsResp.JSON200.Result {
+	for perm, err := range utils.Pages(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+
 		if perm.PermissionId.String() == id {
 			return &perm, nil
 		}
 	}
 
-	if permissionsResp.JSON200.Pagination.TotalPages > float32(page) {
-		return r.findPermissionByID(ctx, id, page+1)
-	}
-
 	return nil, fmt.Errorf("permission %s not found", id)
 }
 
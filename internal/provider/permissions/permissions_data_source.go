@@ -3,6 +3,7 @@ package permissions
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -188,7 +189,7 @@ func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	tflog.Trace(ctx, "Fetching filtered permissions from Entitle API")
 
-	var reqStatusCode int
+	var reqHTTPResponse *http.Response
 	var reqBody []byte
 	var reqResponse []client.PermissionSchema
 	if data.Filter.AccountID.ValueString() != "" {
@@ -209,7 +210,7 @@ func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 			return
 		}
 
-		reqStatusCode = permissionsResp.StatusCode()
+		reqHTTPResponse = permissionsResp.HTTPResponse
 		reqBody = permissionsResp.Body
 		if permissionsResp.JSON200 != nil {
 			reqResponse = permissionsResp.JSON200.Result
@@ -223,14 +224,14 @@ func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 			return
 		}
 
-		reqStatusCode = permissionsResp.StatusCode()
+		reqHTTPResponse = permissionsResp.HTTPResponse
 		reqBody = permissionsResp.Body
 		if permissionsResp.JSON200 != nil {
 			reqResponse = permissionsResp.JSON200.Result
 		}
 	}
 
-	if err := utils.HTTPResponseToError(reqStatusCode, reqBody); err != nil {
+	if err := utils.ResponseToError(reqHTTPResponse, reqBody); err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(), fmt.Sprintf("Failed to list permissions: %s", err))
 		return
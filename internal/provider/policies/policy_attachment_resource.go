@@ -0,0 +1,453 @@
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyAttachmentResource{}
+var _ resource.ResourceWithImportState = &PolicyAttachmentResource{}
+var _ resource.ResourceWithIdentity = &PolicyAttachmentResource{}
+
+// policyAttachmentImportFormat is the format of the id and import ID of an
+// entitle_policy_attachment.
+const policyAttachmentImportFormat = "<policy_id>/<entity_id>"
+
+// policyAttachmentIdentityPaths maps the identity attributes to their state
+// attributes.
+var policyAttachmentIdentityPaths = utils.IdentityPaths{
+	"policy_id": path.Root("policy_id"),
+	"entity_id": path.Root("entity_id"),
+}
+
+// Types of the entities attached to a policy: the roles and bundles it
+// grants, and the IdP groups and schedules it applies to.
+const (
+	policyAttachmentTypeRole     = "role"
+	policyAttachmentTypeBundle   = "bundle"
+	policyAttachmentTypeGroup    = string(client.EnumPolicyGroupTypeGroup)
+	policyAttachmentTypeSchedule = string(client.EnumPolicyGroupTypeSchedule)
+)
+
+// NewPolicyAttachmentResource creates a new instance of the PolicyAttachmentResource.
+func NewPolicyAttachmentResource() resource.Resource {
+	return &PolicyAttachmentResource{}
+}
+
+// PolicyAttachmentResource defines the resource implementation. It adds a
+// single role, bundle or group to a policy, keeping its other ones.
+type PolicyAttachmentResource struct {
+	client *client.ClientWithResponses
+}
+
+// PolicyAttachmentResourceModel describes the resource data model.
+type PolicyAttachmentResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Type     types.String `tfsdk:"type"`
+	EntityID types.String `tfsdk:"entity_id"`
+}
+
+// Metadata sets the metadata for the resource.
+func (r *PolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_attachment"
+}
+
+// Schema sets the schema for the resource.
+func (r *PolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.PolicyAttachmentResourceMarkdownDescription,
+		Description:         "Adds a single role, bundle or IdP group to a policy, keeping its other ones.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the attachment, in the format " + policyAttachmentImportFormat + ".",
+				MarkdownDescription: "Identifier of the attachment, in the format `" + policyAttachmentImportFormat + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The id of the policy to attach the entity to.",
+				MarkdownDescription: "The id of the policy to attach the entity to.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Description: "The type of the entity: \"role\" or \"bundle\" to grant it by the policy, " +
+					"\"group\" or \"schedule\" to apply the policy to its members.",
+				MarkdownDescription: "The type of the entity: `role` or `bundle` to grant it by the policy, " +
+					"`group` or `schedule` to apply the policy to its members.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						policyAttachmentTypeRole,
+						policyAttachmentTypeBundle,
+						policyAttachmentTypeGroup,
+						policyAttachmentTypeSchedule,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The id of the role, bundle, IdP group or schedule to attach to the policy.",
+				MarkdownDescription: "The id of the role, bundle, IdP group or schedule to attach to the policy.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *PolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// Create attaches the entity to the policy.
+func (r *PolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, entityID, diags := parsePolicyAttachmentIDs(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updatePolicyAttachment(ctx, policyID, plan.Type.ValueString(), entityID, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to attach the %s (%s) to the policy (%s): %s", plan.Type.ValueString(), entityID, policyID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(policyID.String() + "/" + entityID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, policyAttachmentIdentityPaths)...)
+}
+
+// Read checks the entity is still attached to the policy. When it is not, it
+// is removed from state, so the next apply attaches it again.
+func (r *PolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, entityID, diags := parsePolicyAttachmentIDs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.policy(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to get the policy (%s): %s", policyID, err),
+		)
+		return
+	}
+
+	// An import gives the entity id only; the type is the one it is
+	// attached as.
+	if data.Type.IsNull() {
+		data.Type = types.StringValue(policyAttachmentType(policy, entityID))
+	}
+
+	if !slices.Contains(policyAttachments(policy, data.Type.ValueString()), entityID) {
+		resp.Diagnostics.AddWarning(
+			"Policy Attachment Removed",
+			fmt.Sprintf("The %s (%s) is no longer attached to the policy (%s) and will be attached again. "+
+				"If the policy is managed by an entitle_policy, its roles, bundles and in_groups attributes remove it on every apply.",
+				data.Type.ValueString(), entityID, policyID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, policyAttachmentIdentityPaths)...)
+}
+
+// Update stores the plan; every change of the attachment replaces it.
+func (r *PolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, policyAttachmentIdentityPaths)...)
+}
+
+// Delete detaches the entity from the policy, keeping its other ones.
+func (r *PolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, entityID, diags := parsePolicyAttachmentIDs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updatePolicyAttachment(ctx, policyID, data.Type.ValueString(), entityID, false)
+	if err != nil && !errors.Is(err, utils.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to detach the %s (%s) from the policy (%s): %s", data.Type.ValueString(), entityID, policyID, err),
+		)
+	}
+}
+
+// IdentitySchema defines the identity of the entity attached to a policy:
+// its policy's and entity's IDs.
+func (r *PolicyAttachmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"policy_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the policy.",
+			},
+			"entity_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the attached entity.",
+			},
+		},
+	}
+}
+
+// ImportState imports the entity attached to a policy by an ID in the format
+// <policy_id>/<entity_id>, or by its identity.
+func (r *PolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = utils.IdentityImportID(ctx, req.Identity, "policy_id", "entity_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ids, err := utils.SplitImportID(importID, policyAttachmentImportFormat)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &PolicyAttachmentResourceModel{
+		ID:       types.StringValue(ids[0].String() + "/" + ids[1].String()),
+		PolicyID: types.StringValue(ids[0].String()),
+		Type:     types.StringNull(),
+		EntityID: types.StringValue(ids[1].String()),
+	})...)
+}
+
+// updatePolicyAttachment attaches the entity to the policy, or detaches it
+// when present is false, with a read-modify-write that keeps the other
+// entities. The policy is locked meanwhile, so parallel attachments to it do
+// not drop each other's entities.
+func (r *PolicyAttachmentResource) updatePolicyAttachment(
+	ctx context.Context,
+	policyID uuid.UUID,
+	attachmentType string,
+	entityID uuid.UUID,
+	present bool,
+) error {
+	unlock := utils.LockObject(utils.ObjectKindPolicy, policyID)
+	defer unlock()
+
+	policy, err := r.policy(ctx, policyID)
+	if err != nil {
+		return err
+	}
+
+	ids := policyAttachments(policy, attachmentType)
+	if slices.Contains(ids, entityID) == present {
+		return nil
+	}
+
+	ids = slices.DeleteFunc(ids, func(id uuid.UUID) bool { return id == entityID })
+	if present {
+		ids = append(ids, entityID)
+	}
+
+	// Only the changed list is sent; the update leaves the omitted ones as
+	// they are.
+	var body client.PolicyUpdateSchema
+	switch attachmentType {
+	case policyAttachmentTypeRole:
+		body.Roles = idParams(ids)
+	case policyAttachmentTypeBundle:
+		body.Bundles = idParams(ids)
+	default:
+		inGroups := make([]client.InGroupSchema, 0, len(policy.InGroups)+1)
+		for _, group := range policy.InGroups {
+			if string(group.Type) != attachmentType || slices.Contains(ids, group.Id) {
+				inGroups = append(inGroups, client.InGroupSchema{Id: group.Id.String(), Type: group.Type})
+			}
+		}
+		if present {
+			inGroups = append(inGroups, client.InGroupSchema{
+				Id:   entityID.String(),
+				Type: client.EnumPolicyGroupType(attachmentType),
+			})
+		}
+		body.InGroups = &inGroups
+	}
+
+	apiResp, err := r.client.PoliciesUpdateWithResponse(ctx, policyID, body)
+	if err != nil {
+		return err
+	}
+
+	return utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+}
+
+// policy returns the policy with the given ID.
+func (r *PolicyAttachmentResource) policy(ctx context.Context, policyID uuid.UUID) (*client.FullPolicyResultResponseSchema, error) {
+	apiResp, err := r.client.PoliciesShowWithResponse(ctx, policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+		return nil, err
+	}
+
+	return &apiResp.JSON200.Result, nil
+}
+
+// policyAttachments returns the IDs of the entities of the given type
+// attached to the policy.
+func policyAttachments(policy *client.FullPolicyResultResponseSchema, attachmentType string) []uuid.UUID {
+	var ids []uuid.UUID
+	switch attachmentType {
+	case policyAttachmentTypeRole:
+		for _, role := range policy.Roles {
+			ids = append(ids, role.Id)
+		}
+	case policyAttachmentTypeBundle:
+		for _, bundle := range policy.Bundles {
+			ids = append(ids, bundle.Id)
+		}
+	default:
+		for _, group := range policy.InGroups {
+			if string(group.Type) == attachmentType {
+				ids = append(ids, group.Id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// policyAttachmentType returns the type the entity is attached to the policy
+// as, or "role" when it is not attached.
+func policyAttachmentType(policy *client.FullPolicyResultResponseSchema, entityID uuid.UUID) string {
+	for _, attachmentType := range []string{
+		policyAttachmentTypeBundle,
+		policyAttachmentTypeGroup,
+		policyAttachmentTypeSchedule,
+	} {
+		if slices.Contains(policyAttachments(policy, attachmentType), entityID) {
+			return attachmentType
+		}
+	}
+
+	return policyAttachmentTypeRole
+}
+
+// idParams returns the IdParamsSchema of each ID.
+func idParams(ids []uuid.UUID) *[]client.IdParamsSchema {
+	params := make([]client.IdParamsSchema, len(ids))
+	for i, id := range ids {
+		params[i] = client.IdParamsSchema{Id: id}
+	}
+
+	return &params
+}
+
+// parsePolicyAttachmentIDs parses the policy and entity IDs of the model.
+func parsePolicyAttachmentIDs(data PolicyAttachmentResourceModel) (uuid.UUID, uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policyID, err := uuid.Parse(data.PolicyID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to parse the policy id to UUID: %v", err))
+		return uuid.Nil, uuid.Nil, diags
+	}
+
+	entityID, err := uuid.Parse(data.EntityID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to parse the entity id to UUID: %v", err))
+		return uuid.Nil, uuid.Nil, diags
+	}
+
+	return policyID, entityID, diags
+}
@@ -0,0 +1,118 @@
+package policies_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestPolicyAttachmentResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "GitHub",
+		"application": map[string]any{"name": "github"},
+	})
+	resourceID := srv.Add(fakeentitle.Resources, map[string]any{
+		"name":        "my-repo",
+		"integration": map[string]any{"id": integrationID},
+	})
+	adminID := srv.Add(fakeentitle.Roles, map[string]any{"name": "admin", "resource": map[string]any{"id": resourceID}})
+	readID := srv.Add(fakeentitle.Roles, map[string]any{"name": "read", "resource": map[string]any{"id": resourceID}})
+	bundleID := srv.Add(fakeentitle.Bundles, map[string]any{"name": "My Bundle"})
+	engineering := srv.AddGroup("engineering", "engineering@example.com")
+	oncall := srv.AddGroup("oncall", "oncall@example.com")
+
+	// The policy already has a role and a group that the attachments must keep.
+	policyID := srv.Add(fakeentitle.Policies, map[string]any{
+		"number":    float64(1),
+		"sortOrder": float64(0),
+		"inGroups":  []any{map[string]any{"type": "group", "id": engineering.ID}},
+		"roles":     []any{map[string]any{"id": adminID}},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			checkPolicyEntities(srv, policyID, "roles", adminID),
+			checkPolicyEntities(srv, policyID, "bundles"),
+			checkPolicyEntities(srv, policyID, "inGroups", engineering.ID),
+		),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_policy_attachment" "my_group" {
+	policy_id = "%[1]s"
+	type      = "group"
+	entity_id = "%[2]s"
+}
+
+resource "entitle_policy_attachment" "my_role" {
+	policy_id = "%[1]s"
+	type      = "role"
+	entity_id = "%[3]s"
+}
+
+resource "entitle_policy_attachment" "my_bundle" {
+	policy_id = "%[1]s"
+	type      = "bundle"
+	entity_id = "%[4]s"
+}
+`, policyID, oncall.ID, readID, bundleID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_policy_attachment.my_bundle", "id", policyID+"/"+bundleID),
+					checkPolicyEntities(srv, policyID, "roles", adminID, readID),
+					checkPolicyEntities(srv, policyID, "bundles", bundleID),
+					checkPolicyEntities(srv, policyID, "inGroups", engineering.ID, oncall.ID),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("entitle_policy_attachment.my_bundle", map[string]knownvalue.Check{
+						"policy_id": knownvalue.StringExact(policyID),
+						"entity_id": knownvalue.StringExact(bundleID),
+					}),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_policy_attachment.my_bundle",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// checkPolicyEntities checks the IDs of the entities listed in the given
+// field of a policy in the fake are ids, in any order.
+func checkPolicyEntities(srv *fakeentitle.Server, policyID, field string, ids ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		policy, ok := srv.Get(fakeentitle.Policies, policyID)
+		if !ok {
+			return fmt.Errorf("policy %s not found", policyID)
+		}
+
+		var got []string
+		entities, _ := policy[field].([]any)
+		for _, entity := range entities {
+			got = append(got, fmt.Sprint(entity.(map[string]any)["id"]))
+		}
+
+		slices.Sort(got)
+		want := slices.Sorted(slices.Values(ids))
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected policy %s %s to be %v, got %v", policyID, field, want, got)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+//go:build acceptance
+
+package policies_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestPolicyAttachmentResource(t *testing.T) {
+	if os.Getenv("ENTITLE_DIRECTORY_GROUP_ID") == "" {
+		t.SkipNow()
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+
+resource "entitle_policy" "my_policy" {
+	in_groups = [
+		{
+			id = "%s"
+			type = "group"
+		}
+	]
+
+	lifecycle {
+		ignore_changes = [roles, bundles]
+	}
+}
+
+resource "entitle_policy_attachment" "my_role" {
+	policy_id = entitle_policy.my_policy.id
+	type      = "role"
+	entity_id = "%s"
+}
+
+resource "entitle_policy_attachment" "my_bundle" {
+	policy_id = entitle_policy.my_policy.id
+	type      = "bundle"
+	entity_id = "%s"
+}
+`, os.Getenv("ENTITLE_DIRECTORY_GROUP_ID"), os.Getenv("ENTITLE_ROLE_ID"), os.Getenv("ENTITLE_BUNDLE_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttrPair("entitle_policy_attachment.my_role", "policy_id", "entitle_policy.my_policy", "id"),
+					resource.TestCheckResourceAttr("entitle_policy_attachment.my_role", "type", "role"),
+					resource.TestCheckResourceAttr("entitle_policy_attachment.my_role", "entity_id", os.Getenv("ENTITLE_ROLE_ID")),
+					resource.TestCheckResourceAttr("entitle_policy_attachment.my_bundle", "type", "bundle"),
+					resource.TestCheckResourceAttr("entitle_policy_attachment.my_bundle", "entity_id", os.Getenv("ENTITLE_BUNDLE_ID")),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_policy_attachment.my_role", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_policy_attachment.my_bundle",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -235,7 +235,7 @@ func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	err = utils.HTTPResponseToError(policyResp.HTTPResponse.StatusCode, policyResp.Body)
+	err = utils.ResponseToError(policyResp.HTTPResponse, policyResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -0,0 +1,99 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &PolicyListResource{}
+var _ list.ListResourceWithConfigure = &PolicyListResource{}
+
+// NewPolicyListResource creates a new instance of the PolicyListResource.
+func NewPolicyListResource() list.ListResource {
+	return &PolicyListResource{}
+}
+
+// PolicyListResource lists policies for Terraform query.
+type PolicyListResource struct {
+	client *client.ClientWithResponses
+}
+
+// PolicyListResourceModel describes the list resource configuration. Policies
+// have no filters.
+type PolicyListResourceModel struct{}
+
+// Metadata sets the metadata for the list resource, which lists entitle_policy resources.
+func (r *PolicyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *PolicyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.PolicyListResourceMarkdownDescription,
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *PolicyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each policy matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *PolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PolicyListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, utils.IDIdentityPaths,
+		func(ctx context.Context, page int) ([]client.PolicyIndexResultResponseSchema, int, error) {
+			apiResp, err := r.client.PoliciesIndexWithResponse(ctx, &client.PoliciesIndexParams{
+				Page:    utils.Float32Pointer(float32(page)),
+				PerPage: utils.Float32Pointer(100),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, p client.PolicyIndexResultResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), p.Id.String())...)
+			return fmt.Sprintf("Policy #%d", int(p.Number)), diags
+		},
+	)
+}
@@ -26,6 +26,15 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PolicyResource{}
 var _ resource.ResourceWithImportState = &PolicyResource{}
+var _ resource.ResourceWithIdentity = &PolicyResource{}
+
+// policyFieldPaths maps policy request body fields to their attributes.
+var policyFieldPaths = utils.APIFieldPaths{
+	"inGroups":  path.Root("in_groups"),
+	"bundles":   path.Root("bundles"),
+	"roles":     path.Root("roles"),
+	"sortOrder": path.Root("sort_order"),
+}
 
 func NewPolicyResource() resource.Resource {
 	return &PolicyResource{}
@@ -268,8 +277,12 @@ func (r *PolicyResource) Create(
 		return
 	}
 
-	err = utils.HTTPResponseToError(policyResp.HTTPResponse.StatusCode, policyResp.Body)
+	err = utils.ResponseToError(policyResp.HTTPResponse, policyResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, policyFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -304,6 +317,8 @@ func (r *PolicyResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read this function is used to read an existing resource of type Entitle Policy.
@@ -343,7 +358,7 @@ func (r *PolicyResource) Read(
 		return
 	}
 
-	err = utils.HTTPResponseToError(policyResp.HTTPResponse.StatusCode, policyResp.Body)
+	err = utils.ResponseToError(policyResp.HTTPResponse, policyResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -376,6 +391,8 @@ func (r *PolicyResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update this function handles updates to an existing resource of type Entitle Policy.
@@ -438,7 +455,7 @@ func (r *PolicyResource) Update(
 		return
 	}
 
-	err = utils.HTTPResponseToError(policyResp.HTTPResponse.StatusCode, policyResp.Body)
+	err = utils.ResponseToError(policyResp.HTTPResponse, policyResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -447,6 +464,10 @@ func (r *PolicyResource) Update(
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, policyFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -477,6 +498,8 @@ func (r *PolicyResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Delete this function is responsible for deleting an existing resource of type
@@ -516,7 +539,7 @@ func (r *PolicyResource) Delete(
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -531,16 +554,25 @@ func (r *PolicyResource) Delete(
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *PolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
-// It extracts the resource's identifier from the import request and sets
-// it in Terraform state using resource.ImportStatePassthroughID.
+// Policies have no name, so the import ID is the policy's UUID or
+// number:<policy number>.
 func (r *PolicyResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateByID(ctx, req, resp, utils.ImportResolvers{
+		Number: func(ctx context.Context, number int) (*uuid.UUID, error) {
+			return utils.FindPolicyIDByNumber(ctx, r.client, number)
+		},
+	})
 }
 
 // convertFullPolicyResultResponseSchemaToModel is a utility function used to convert the API response data
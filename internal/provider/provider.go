@@ -5,8 +5,12 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,17 +22,21 @@ import (
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/accessRequestForwards"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/accessRequests"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/accessReviewForwards"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/accounts"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/agentTokens"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/applications"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/auditLogs"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/bundles"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/directoryGroups"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/functions"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/integrations"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/permissions"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/policies"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/resources"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/roles"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/userAccounts"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/users"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/workflows"
 )
@@ -39,6 +47,9 @@ const (
 
 // Ensure EntitleProvider satisfies various provider interfaces.
 var _ provider.Provider = &EntitleProvider{}
+var _ provider.ProviderWithEphemeralResources = &EntitleProvider{}
+var _ provider.ProviderWithListResources = &EntitleProvider{}
+var _ provider.ProviderWithFunctions = &EntitleProvider{}
 
 // EntitleProvider defines the provider implementation.
 type EntitleProvider struct {
@@ -50,8 +61,16 @@ type EntitleProvider struct {
 
 // EntitleProviderModel describes the provider data model.
 type EntitleProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint            types.String    `tfsdk:"endpoint"`
+	AllowCustomEndpoint types.Bool      `tfsdk:"allow_custom_endpoint"`
+	Region              types.String    `tfsdk:"region"`
+	APIKey              types.String    `tfsdk:"api_key"`
+	ProxyURL            types.String    `tfsdk:"proxy_url"`
+	RequestTimeout      types.String    `tfsdk:"request_timeout"`
+	DisableLookupCache  types.Bool      `tfsdk:"disable_lookup_cache"`
+	Retry               *RetryModel     `tfsdk:"retry"`
+	RateLimit           *RateLimitModel `tfsdk:"rate_limit"`
+	TLS                 *TLSModel       `tfsdk:"tls"`
 }
 
 // Metadata sets the provider metadata.
@@ -77,15 +96,31 @@ func (p *EntitleProvider) Schema(
 				MarkdownDescription: "Entitle API server address. Allowed values:\n\n" +
 					"  - https://api.entitle.io (default, Europe)\n" +
 					"  - https://api.ca.entitle.io (Canada)\n" +
-					"  - https://api.us.entitle.io (United States)\n",
-				Description: "Entitle API server address. Allowed values: https://api.entitle.io (default, Europe), https://api.ca.entitle.io (Canada), https://api.us.entitle.io (United States)",
-				Optional:    true,
+					"  - https://api.us.entitle.io (United States)\n\n" +
+					"Any other HTTPS URL is accepted when `allow_custom_endpoint` is `true`. " +
+					"Can also be set via the `ENTITLE_API_ENDPOINT` environment variable.",
+				Description: "Entitle API server address. Allowed values: https://api.entitle.io (default, Europe), https://api.ca.entitle.io (Canada), https://api.us.entitle.io (United States). " +
+					"Any other HTTPS URL is accepted when allow_custom_endpoint is true. " +
+					"Can also be set via the ENTITLE_API_ENDPOINT environment variable.",
+				Optional: true,
+			},
+			"allow_custom_endpoint": schema.BoolAttribute{
+				MarkdownDescription: "Accept an `endpoint` other than the public Entitle regions, such as a staging tenant " +
+					"or a local stand-in of the API. Plain `http` is only accepted for `localhost`. Defaults to `false`.",
+				Description: "Accept an endpoint other than the public Entitle regions, such as a staging tenant " +
+					"or a local stand-in of the API. Plain http is only accepted for localhost. Defaults to false.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("endpoint")),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Shorthand for the `endpoint` of a public Entitle region: `eu` (default), `ca` or `us`. Cannot be combined with `endpoint`.",
+				Description:         "Shorthand for the endpoint of a public Entitle region: eu (default), ca or us. Cannot be combined with endpoint.",
+				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"https://api.entitle.io",
-						"https://api.ca.entitle.io",
-						"https://api.us.entitle.io",
-					),
+					stringvalidator.OneOf("eu", "ca", "us"),
+					stringvalidator.ConflictsWith(path.MatchRoot("endpoint")),
 				},
 			},
 			"api_key": schema.StringAttribute{
@@ -94,6 +129,22 @@ func (p *EntitleProvider) Schema(
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"proxy_url":       proxyURLAttribute,
+			"request_timeout": requestTimeoutAttribute,
+			"disable_lookup_cache": schema.BoolAttribute{
+				MarkdownDescription: "Disable the cache of the list requests made to find objects by name or external ID, " +
+					"such as the roles and resources of `entitle_role_synced` and `entitle_resource_synced`. " +
+					"The cache lives for a single plan or apply and is cleared of a collection on every write to it. Defaults to `false`.",
+				Description: "Disable the cache of the list requests made to find objects by name or external ID, " +
+					"such as the roles and resources of entitle_role_synced and entitle_resource_synced. " +
+					"The cache lives for a single plan or apply and is cleared of a collection on every write to it. Defaults to false.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry":      retryBlock,
+			"rate_limit": rateLimitBlock,
+			"tls":        tlsBlock,
 		},
 	}
 }
@@ -130,22 +181,18 @@ func (p *EntitleProvider) Configure(
 		)
 	}
 
+	// Retrieve server value from configuration or environment variables.
+	server := endpointFromConfig(config, &resp.Diagnostics)
+
+	requestTimeout := requestTimeoutFromConfig(config, &resp.Diagnostics)
+	retryOptions := retryOptionsFromConfig(config, &resp.Diagnostics)
+	transport := httpTransportFromConfig(config, &resp.Diagnostics)
+
 	// Check for errors before proceeding.
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Retrieve server value from environment variables or configuration.
-	server := os.Getenv("ENTITLE_API_ENDPOINT")
-	if !config.Endpoint.IsNull() {
-		server = config.Endpoint.ValueString()
-	}
-
-	if server == "" {
-		config.Endpoint = types.StringValue(defaultAPIServer)
-		server = defaultAPIServer
-	}
-
 	// Set log fields and create Entitle client.
 	ctx = tflog.SetField(ctx, "entitle_endpoint", server)
 	ctx = tflog.SetField(ctx, "entitle_token", token)
@@ -153,17 +200,31 @@ func (p *EntitleProvider) Configure(
 
 	tflog.Debug(ctx, "Creating entitle client...")
 
-	// Build an HTTP client with a per-request timeout
+	// Build an HTTP client with a per-request timeout and the proxy and TLS settings
 	httpClient := &http.Client{
-		Timeout: client.DefaultRequestTimeout,
+		Timeout:   requestTimeout,
+		Transport: transport,
+	}
+
+	// Throttle requests when a rate limit is configured. The limiter sits below
+	// the retry logic so every retry attempt also waits for a token.
+	var doer client.HttpRequestDoer = httpClient
+	if config.RateLimit != nil {
+		doer = client.NewRateLimitDoer(doer, rateLimitOptionsFromConfig(config.RateLimit)...)
 	}
 
 	// Wrap the HTTP client with retry logic.
-	retryClient := client.NewRetryDoer(httpClient)
+	doer = client.NewRetryDoer(doer, retryOptions...)
+
+	// Cache lookups above the retry logic, so cache hits neither wait for a
+	// rate limit token nor count against the retry budget.
+	if !config.DisableLookupCache.ValueBool() {
+		doer = client.NewLookupCacheDoer(doer)
+	}
 
 	c, err := client.NewClientWithResponses(
 		server,
-		client.WithHTTPClient(retryClient),
+		client.WithHTTPClient(doer),
 		client.WithRequestEditorFn(
 			client.SetBearerToken(token),
 		),
@@ -181,6 +242,8 @@ func (p *EntitleProvider) Configure(
 	// Set client configuration for data sources and resources.
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
 
 	tflog.Info(ctx, "Configured Entitle client", map[string]any{"success": true})
 }
@@ -189,41 +252,88 @@ func (p *EntitleProvider) Configure(
 func (p *EntitleProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		accessRequestForwards.NewAccessRequestForwardResource,
+		accessRequests.NewAccessRequestResource,
 		accessReviewForwards.NewAccessReviewForwardResource,
 		agentTokens.NewAgentTokenResource,
 		bundles.NewBundleResource,
+		bundles.NewBundleRoleResource,
 		integrations.NewIntegrationResource,
 		integrations.NewIntegrationGitlabResource,
+		integrations.NewIntegrationMaintainerResource,
 		permissions.NewPermissionResource,
 		policies.NewPolicyResource,
+		policies.NewPolicyAttachmentResource,
 		resources.NewResourceResource,
 		resources.NewResourceSyncedResource,
+		resources.NewResourcesSyncedSetResource,
+		resources.NewResourceMaintainerResource,
 		roles.NewRoleResource,
 		roles.NewRoleSyncedResource,
+		roles.NewRolesSyncedSetResource,
+		userAccounts.NewUserAccountResource,
 		workflows.NewWorkflowResource,
 	}
 }
 
+// EphemeralResources returns the list of provider ephemeral resources.
+func (p *EntitleProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		agentTokens.NewAgentTokenEphemeralResource,
+	}
+}
+
+// ListResources returns the list of provider list resources.
+func (p *EntitleProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		agentTokens.NewAgentTokenListResource,
+		bundles.NewBundleListResource,
+		integrations.NewIntegrationListResource,
+		policies.NewPolicyListResource,
+		resources.NewResourceListResource,
+		roles.NewRoleListResource,
+		workflows.NewWorkflowListResource,
+	}
+}
+
+// Functions returns the list of provider functions.
+func (p *EntitleProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewApprovalStepFunction,
+		functions.NewDurationFunction,
+		functions.NewGroupFunction,
+		functions.NewUserFunction,
+	}
+}
+
 // DataSources returns the list of provider data sources.
 func (p *EntitleProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		accounts.NewAccountsDataSource,
 		accessRequestForwards.NewAccessRequestForwardDataSource,
+		accessRequestForwards.NewAccessRequestForwardsDataSource,
 		accessReviewForwards.NewAccessReviewForwardDataSource,
+		accessReviewForwards.NewAccessReviewForwardsDataSource,
 		agentTokens.NewAgentTokenDataSource,
+		agentTokens.NewAgentTokensDataSource,
 		applications.NewApplicationsDataSource,
+		auditLogs.NewAuditLogsDataSource,
 		bundles.NewBundleDataSource,
+		bundles.NewBundlesDataSource,
 		directoryGroups.NewDirectoryGroupsDataSource,
 		integrations.NewIntegrationDataSource,
+		integrations.NewIntegrationsDataSource,
 		permissions.NewPermissionsDataSource,
 		policies.NewPolicyDataSource,
+		policies.NewPoliciesDataSource,
 		resources.NewResourcesDataSource,
 		resources.NewResourceDataSource,
 		roles.NewRoleDataSource,
 		roles.NewRolesDataSource,
 		users.NewUserDataSource,
+		userAccounts.NewUserAccountsDataSource,
 		users.NewUsersDataSource,
 		workflows.NewWorkflowDataSource,
+		workflows.NewWorkflowsDataSource,
 	}
 }
 
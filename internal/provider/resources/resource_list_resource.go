@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ResourceListResource{}
+var _ list.ListResourceWithConfigure = &ResourceListResource{}
+
+// NewResourceListResource creates a new instance of the ResourceListResource.
+func NewResourceListResource() list.ListResource {
+	return &ResourceListResource{}
+}
+
+// ResourceListResource lists resources for Terraform query.
+type ResourceListResource struct {
+	client *client.ClientWithResponses
+}
+
+// ResourceListResourceModel describes the list resource configuration.
+type ResourceListResourceModel struct {
+	IntegrationID types.String `tfsdk:"integration_id"`
+	Search        types.String `tfsdk:"search"`
+}
+
+// Metadata sets the metadata for the list resource, which lists entitle_resource resources.
+func (r *ResourceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *ResourceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.ResourceListResourceMarkdownDescription,
+		Attributes: map[string]listschema.Attribute{
+			"integration_id": listschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the integration to list the resources of.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+			},
+			"search": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Search string to filter resources by name.",
+			},
+		},
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *ResourceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each resource matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *ResourceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ResourceListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, resourceIdentityPaths,
+		func(ctx context.Context, page int) ([]client.IntegrationResourceListItemResponseSchema, int, error) {
+			apiResp, err := r.client.ResourcesIndexWithResponse(ctx, &client.ResourcesIndexParams{
+				Page:          utils.IntPointer(page),
+				PerPage:       utils.IntPointer(100),
+				Search:        data.Search.ValueStringPointer(),
+				IntegrationId: data.IntegrationID.ValueString(),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, r client.IntegrationResourceListItemResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), r.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("name"), r.Name)...)
+			diags.Append(res.SetAttribute(ctx, path.Root("integration").AtName("id"), r.Integration.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("integration").AtName("name"), r.Integration.Name)...)
+			return fmt.Sprintf("%s/%s", r.Integration.Name, r.Name), diags
+		},
+	)
+}
@@ -0,0 +1,326 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceMaintainerResource{}
+var _ resource.ResourceWithImportState = &ResourceMaintainerResource{}
+var _ resource.ResourceWithIdentity = &ResourceMaintainerResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceMaintainerResource{}
+
+// resourceMaintainerImportFormat is the format of the id and import ID of
+// an entitle_resource_maintainer.
+const resourceMaintainerImportFormat = "<resource_id>/<entity_id>"
+
+// resourceMaintainerIdentityPaths maps the identity attributes to their state
+// attributes.
+var resourceMaintainerIdentityPaths = utils.IdentityPaths{
+	"resource_id": path.Root("resource_id"),
+	"entity_id":   path.Root("entity").AtName("id"),
+}
+
+// NewResourceMaintainerResource creates a new instance of the ResourceMaintainerResource.
+func NewResourceMaintainerResource() resource.Resource {
+	return &ResourceMaintainerResource{}
+}
+
+// ResourceMaintainerResource defines the resource implementation. It adds a
+// single maintainer to a resource, keeping its other maintainers.
+type ResourceMaintainerResource struct {
+	client *client.ClientWithResponses
+}
+
+// ResourceMaintainerResourceModel describes the resource data model.
+type ResourceMaintainerResourceModel struct {
+	ID         types.String        `tfsdk:"id"`
+	ResourceID types.String        `tfsdk:"resource_id"`
+	Type       types.String        `tfsdk:"type"`
+	Entity     *utils.IdEmailModel `tfsdk:"entity"`
+}
+
+// Metadata sets the metadata for the resource.
+func (r *ResourceMaintainerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_maintainer"
+}
+
+// Schema sets the schema for the resource.
+func (r *ResourceMaintainerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.ResourceMaintainerResourceMarkdownDescription,
+		Description:         "Adds a single user or group maintainer to a resource, keeping its other maintainers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the attachment, in the format " + resourceMaintainerImportFormat + ".",
+				MarkdownDescription: "Identifier of the attachment, in the format `" + resourceMaintainerImportFormat + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The id of the resource to add the maintainer to.",
+				MarkdownDescription: "The id of the resource to add the maintainer to.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type":   utils.MaintainerAttachmentTypeAttribute(),
+			"entity": utils.MaintainerAttachmentEntityAttribute(),
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *ResourceMaintainerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan resolves the maintainer configured by email to its ID.
+func (r *ResourceMaintainerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	utils.ResolveMaintainerEntity(ctx, r.client, req, resp)
+}
+
+// Create adds the maintainer to the resource.
+func (r *ResourceMaintainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceMaintainerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, err := uuid.Parse(plan.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to parse the resource id to UUID: %v", err))
+		return
+	}
+
+	unlock := utils.LockObject(utils.ObjectKindResource, resourceID)
+	defer unlock()
+
+	maintainer := utils.Maintainer{Type: plan.Type.ValueString(), ID: plan.Entity.Id.ValueString()}
+	err = utils.UpdateMaintainer(ctx, r.maintainers(resourceID), maintainer, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to add the maintainer %s to the resource (%s): %s", maintainer.ID, resourceID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(resourceID.String() + "/" + maintainer.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, resourceMaintainerIdentityPaths)...)
+}
+
+// Read checks the maintainer is still one of the resource's. When it is
+// not, it is removed from state, so the next apply adds it again.
+func (r *ResourceMaintainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceMaintainerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, err := uuid.Parse(data.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to parse the resource id to UUID: %v", err))
+		return
+	}
+
+	maintainers, err := r.maintainers(resourceID).Get(ctx)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to get the maintainers of the resource (%s): %s", resourceID, err),
+		)
+		return
+	}
+
+	// An import gives the entity id only; the type is the maintainer's.
+	if data.Type.IsNull() {
+		for _, m := range maintainers {
+			if strings.EqualFold(m.ID, data.Entity.Id.ValueString()) {
+				data.Type = types.StringValue(m.Type)
+			}
+		}
+	}
+
+	maintainer := utils.Maintainer{Type: data.Type.ValueString(), ID: data.Entity.Id.ValueString()}
+	if !utils.HasMaintainer(maintainers, maintainer) {
+		resp.Diagnostics.AddWarning(
+			"Maintainer Removed",
+			fmt.Sprintf("The maintainer %s is no longer a maintainer of the resource (%s) and will be added again. "+
+				"If the maintainers attribute of its entitle_resource or entitle_resource_synced is set, it removes this maintainer on every apply.",
+				maintainer.ID, resourceID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, resourceMaintainerIdentityPaths)...)
+}
+
+// Update stores the plan; every change of the maintainer replaces it.
+func (r *ResourceMaintainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResourceMaintainerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, resourceMaintainerIdentityPaths)...)
+}
+
+// Delete removes the maintainer from the resource, keeping its other
+// maintainers.
+func (r *ResourceMaintainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceMaintainerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceID, err := uuid.Parse(data.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to parse the resource id to UUID: %v", err))
+		return
+	}
+
+	unlock := utils.LockObject(utils.ObjectKindResource, resourceID)
+	defer unlock()
+
+	maintainer := utils.Maintainer{Type: data.Type.ValueString(), ID: data.Entity.Id.ValueString()}
+	err = utils.UpdateMaintainer(ctx, r.maintainers(resourceID), maintainer, false)
+	if err != nil && !errors.Is(err, utils.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to remove the maintainer %s from the resource (%s): %s", maintainer.ID, resourceID, err),
+		)
+	}
+}
+
+// IdentitySchema defines the identity of the maintainer of a resource:
+// its resource's and maintainer's IDs.
+func (r *ResourceMaintainerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"resource_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the resource.",
+			},
+			"entity_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the maintainer user or group.",
+			},
+		},
+	}
+}
+
+// ImportState imports the maintainer of a resource by an ID in the format
+// <resource_id>/<entity_id>, or by its identity.
+func (r *ResourceMaintainerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var diags diag.Diagnostics
+		importID, diags = utils.IdentityImportID(ctx, req.Identity, "resource_id", "entity_id")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ids, err := utils.SplitImportID(importID, resourceMaintainerImportFormat)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceMaintainerResourceModel{
+		ID:         types.StringValue(ids[0].String() + "/" + ids[1].String()),
+		ResourceID: types.StringValue(ids[0].String()),
+		Type:       types.StringNull(),
+		Entity: &utils.IdEmailModel{
+			Id:    types.StringValue(ids[1].String()),
+			Email: types.StringNull(),
+		},
+	})...)
+}
+
+// maintainers reads and writes the maintainers of a resource. Writes send
+// the maintainers only, leaving the other settings of the resource as they
+// are.
+func (r *ResourceMaintainerResource) maintainers(resourceID uuid.UUID) utils.MaintainerAccess {
+	return utils.MaintainerAccess{
+		Get: func(ctx context.Context) ([]utils.Maintainer, error) {
+			apiResp, err := r.client.ResourcesShowWithResponse(ctx, resourceID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, err
+			}
+
+			return utils.ParseMaintainers(apiResp.JSON200.Result.Maintainers)
+		},
+		Set: func(ctx context.Context, maintainers []utils.Maintainer) error {
+			body, err := utils.MaintainersUpdateBody(maintainers)
+			if err != nil {
+				return err
+			}
+
+			apiResp, err := r.client.ResourcesUpdateWithBodyWithResponse(ctx, resourceID, "application/json", bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+
+			return utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package resources_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestResourceMaintainerResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	maintainer := srv.AddUser("maintainer@example.com")
+	group := srv.AddGroup("engineering", "engineering@example.com")
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "GitHub",
+		"application": map[string]any{"name": "github"},
+	})
+
+	// The resource already has a maintainer that the attachment must keep.
+	resourceID := srv.Add(fakeentitle.Resources, map[string]any{
+		"name":        "my-repo",
+		"integration": map[string]any{"id": integrationID},
+		"maintainers": []any{map[string]any{"type": "group", "group": map[string]any{"id": group.ID}}},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             checkResourceMaintainers(srv, resourceID, group.ID),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_resource_maintainer" "my_maintainer" {
+	resource_id = "%s"
+	type        = "user"
+	entity = {
+		id = "%s"
+	}
+}
+`, resourceID, maintainer.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_resource_maintainer.my_maintainer", "id", resourceID+"/"+maintainer.ID),
+					checkResourceMaintainers(srv, resourceID, group.ID, maintainer.ID),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("entitle_resource_maintainer.my_maintainer", map[string]knownvalue.Check{
+						"resource_id": knownvalue.StringExact(resourceID),
+						"entity_id":   knownvalue.StringExact(maintainer.ID),
+					}),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_resource_maintainer.my_maintainer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// checkResourceMaintainers checks the IDs of the maintainers of a resource in
+// the fake are ids, in any order.
+func checkResourceMaintainers(srv *fakeentitle.Server, resourceID string, ids ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		res, ok := srv.Get(fakeentitle.Resources, resourceID)
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceID)
+		}
+
+		var got []string
+		maintainers, _ := res["maintainers"].([]any)
+		for _, m := range maintainers {
+			m := m.(map[string]any)
+			entity, _ := m[fmt.Sprint(m["type"])].(map[string]any)
+			got = append(got, fmt.Sprint(entity["id"]))
+		}
+
+		slices.Sort(got)
+		want := slices.Sorted(slices.Values(ids))
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected resource %s maintainers to be %v, got %v", resourceID, want, got)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+//go:build acceptance
+
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestResourceMaintainerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_resource_maintainer" "my_maintainer" {
+	resource_id = "%s"
+	type           = "group"
+	entity = {
+		id = "%s"
+	}
+}
+`, os.Getenv("ENTITLE_RESOURCE_ID"), os.Getenv("ENTITLE_DIRECTORY_GROUP_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_resource_maintainer.my_maintainer", "resource_id", os.Getenv("ENTITLE_RESOURCE_ID")),
+					resource.TestCheckResourceAttr("entitle_resource_maintainer.my_maintainer", "type", "group"),
+					resource.TestCheckResourceAttr("entitle_resource_maintainer.my_maintainer", "entity.id", os.Getenv("ENTITLE_DIRECTORY_GROUP_ID")),
+					resource.TestCheckResourceAttr("entitle_resource_maintainer.my_maintainer", "id",
+						os.Getenv("ENTITLE_RESOURCE_ID")+"/"+os.Getenv("ENTITLE_DIRECTORY_GROUP_ID")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_resource_maintainer.my_maintainer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
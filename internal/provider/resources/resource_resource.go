@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -37,6 +39,36 @@ const tmpDefaultRoleName = "default"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ResourceResource{}
 var _ resource.ResourceWithImportState = &ResourceResource{}
+var _ resource.ResourceWithIdentity = &ResourceResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceResource{}
+
+// resourceIdentityPaths maps the resource identity attributes to their state attributes.
+var resourceIdentityPaths = utils.IdentityPaths{
+	"id":             path.Root("id"),
+	"integration_id": path.Root("integration").AtName("id"),
+	"name":           path.Root("name"),
+}
+
+// resourceIdentityModel is the identity of a resource: its ID, or its integration's ID and its name.
+type resourceIdentityModel struct {
+	ID            types.String `tfsdk:"id"`
+	IntegrationID types.String `tfsdk:"integration_id"`
+	Name          types.String `tfsdk:"name"`
+}
+
+// resourceFieldPaths maps resource request body fields to their attributes.
+var resourceFieldPaths = utils.APIFieldPaths{
+	"name":                    path.Root("name"),
+	"integration":             path.Root("integration"),
+	"requestable":             path.Root("requestable"),
+	"workflow":                path.Root("workflow"),
+	"owner":                   path.Root("owner"),
+	"maintainers":             path.Root("maintainers"),
+	"allowedDurations":        path.Root("allowed_durations"),
+	"prerequisitePermissions": path.Root("prerequisite_permissions"),
+	"userDefinedDescription":  path.Root("user_defined_description"),
+	"userDefinedTags":         path.Root("user_defined_tags"),
+}
 
 func NewResourceResource() resource.Resource {
 	return &ResourceResource{}
@@ -53,6 +85,7 @@ type ResourceResourceModel struct {
 	ExternalID              types.String                        `tfsdk:"external_id"`
 	Name                    types.String                        `tfsdk:"name"`
 	AllowedDurations        types.Set                           `tfsdk:"allowed_durations"`
+	AllowedDurationLabels   types.Set                           `tfsdk:"allowed_duration_labels"`
 	Maintainers             []utils.MaintainerModel             `tfsdk:"maintainers"`
 	Tags                    types.Set                           `tfsdk:"tags"`
 	UserDefinedTags         types.Set                           `tfsdk:"user_defined_tags"`
@@ -66,6 +99,8 @@ type ResourceResourceModel struct {
 
 func (r *ResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource"
+	// The identity includes the resource's name, which can be changed.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -99,15 +134,20 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 			"allowed_durations": schema.SetAttribute{
 				ElementType:         types.NumberType,
 				Optional:            true,
-				Description:         "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
-				MarkdownDescription: "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
+				Computed:            true,
+				Description:         "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nConflicts with allowed_duration_labels.",
+				MarkdownDescription: "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nConflicts with `allowed_duration_labels`.",
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("allowed_duration_labels")),
+					validators.AllowedDurations{},
 				},
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
+					utils.AllowedDurationsFromLabels(),
 				},
 			},
+			"allowed_duration_labels": utils.AllowedDurationLabelsAttribute(),
 			"maintainers": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -119,14 +159,23 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 						"entity": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
 								"id": schema.StringAttribute{
-									Required:            true,
+									Optional:            true,
+									Computed:            true,
 									Description:         "Maintainer's unique identifier",
 									MarkdownDescription: "Maintainer's unique identifier",
+									Validators: []validator.String{
+										stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+									},
 								},
 								"email": schema.StringAttribute{
+									Optional:            true,
 									Computed:            true,
-									Description:         "Maintainer's email",
-									MarkdownDescription: "Maintainer's email",
+									Description:         "Maintainer's email (lowercase), resolved to the user's or group's id at plan time when id is not set",
+									MarkdownDescription: "Maintainer's email (lowercase), resolved to the user's or group's `id` at plan time when `id` is not set",
+									Validators: []validator.String{
+										validators.Email{},
+										validators.Lowercase{},
+									},
 								},
 							},
 							Optional:            true,
@@ -231,8 +280,8 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 					"email": schema.StringAttribute{
 						Optional:            true,
 						Computed:            true,
-						Description:         "the owner's email (lowercase) is used when id was not provided",
-						MarkdownDescription: "the owner's email (lowercase) is used when id was not provided",
+						Description:         "the owner's email (lowercase), resolved to the owner's id at plan time when id is not set",
+						MarkdownDescription: "the owner's email (lowercase), resolved to the owner's `id` at plan time when `id` is not set",
 						Validators: []validator.String{
 							validators.Email{},
 							validators.Lowercase{},
@@ -349,6 +398,14 @@ func (r *ResourceResource) Configure(ctx context.Context, req resource.Configure
 	r.client = c
 }
 
+// ModifyPlan resolves the owner and maintainers configured by email to their
+// IDs, so they are planned and stored in state along with the email, and
+// warns about the maintainers the plan removes.
+func (r *ResourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	utils.ResolveOwnerAndMaintainers(ctx, r.client, req, resp)
+	utils.WarnMaintainersRemoved(ctx, req, resp, "entitle_resource_maintainer")
+}
+
 // Create this function is responsible for creating a new resource of type Entitle Resource.
 //
 // Its reads the Terraform plan data provided in req.Plan and maps it to the ResourceResourceModel.
@@ -558,8 +615,12 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	err = utils.HTTPResponseToError(resourceResp.HTTPResponse.StatusCode, resourceResp.Body)
+	err = utils.ResponseToError(resourceResp.HTTPResponse, resourceResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, resourceFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -601,6 +662,8 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, resourceIdentityPaths)...)
 }
 
 func (r *ResourceResource) MakeUnrequestableDefaultRole(ctx context.Context, resourceID uuid.UUID) error {
@@ -617,7 +680,7 @@ func (r *ResourceResource) MakeUnrequestableDefaultRole(ctx context.Context, res
 		return err
 	}
 
-	err = utils.HTTPResponseToError(response.HTTPResponse.StatusCode, response.Body)
+	err = utils.ResponseToError(response.HTTPResponse, response.Body)
 	if err != nil {
 		return err
 	}
@@ -632,7 +695,7 @@ func (r *ResourceResource) MakeUnrequestableDefaultRole(ctx context.Context, res
 				return err
 			}
 
-			err = utils.HTTPResponseToError(updateResp.HTTPResponse.StatusCode, updateResp.Body)
+			err = utils.ResponseToError(updateResp.HTTPResponse, updateResp.Body)
 			if err != nil {
 				return err
 			}
@@ -675,7 +738,7 @@ func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	err = utils.HTTPResponseToError(resourceResp.HTTPResponse.StatusCode, resourceResp.Body)
+	err = utils.ResponseToError(resourceResp.HTTPResponse, resourceResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -719,6 +782,8 @@ func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, resourceIdentityPaths)...)
 }
 
 // Update this function handles updates to an existing resource of type Entitle Resource.
@@ -871,7 +936,7 @@ func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	err = utils.HTTPResponseToError(resourceResp.HTTPResponse.StatusCode, resourceResp.Body)
+	err = utils.ResponseToError(resourceResp.HTTPResponse, resourceResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -880,6 +945,10 @@ func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateReques
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, resourceFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -907,6 +976,8 @@ func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, resourceIdentityPaths)...)
 }
 
 // Delete this function is responsible for deleting an existing resource of type
@@ -938,7 +1009,7 @@ func (r *ResourceResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -953,12 +1024,65 @@ func (r *ResourceResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// IdentitySchema defines the identity of the resource: its Entitle ID, or its
+// integration's ID and its name.
+func (r *ResourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The Entitle ID (UUID) of the resource.",
+			},
+			"integration_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The ID of the resource's integration. Used with name to import the resource when id is not set.",
+			},
+			"name": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The name of the resource. Used with integration_id to import the resource when id is not set.",
+			},
+		},
+	}
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
-// It extracts the resource's identifier from the import request and sets
-// it in Terraform state using resource.ImportStatePassthroughID.
+// The import ID is the resource's UUID, external:<integration_id>/<external_id>,
+// or integration_name/resource_name.
 func (r *ResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateByID(ctx, req, resp, utils.ImportResolvers{
+		ExternalID: func(ctx context.Context, integrationID uuid.UUID, externalID string) (*uuid.UUID, error) {
+			return utils.FindResourceID(ctx, r.client, integrationID, &externalID, nil)
+		},
+		ExternalIDParent: "integration_id",
+		Path: func(ctx context.Context, names []string) (*uuid.UUID, error) {
+			integrationID, err := utils.FindIntegrationIDByName(ctx, r.client, names[0])
+			if err != nil {
+				return nil, fmt.Errorf("integration %q: %w", names[0], err)
+			}
+
+			resourceID, err := utils.FindResourceID(ctx, r.client, *integrationID, nil, &names[1])
+			if err != nil {
+				return nil, fmt.Errorf("resource %q: %w", names[1], err)
+			}
+
+			return resourceID, nil
+		},
+		PathFormat: "<integration_name>/<resource_name>",
+		Identity: func(ctx context.Context, identity *tfsdk.ResourceIdentity) (*uuid.UUID, error) {
+			var data resourceIdentityModel
+			if diags := identity.Get(ctx, &data); diags.HasError() {
+				return nil, fmt.Errorf("failed to read the import identity")
+			}
+
+			integrationID, err := uuid.Parse(data.IntegrationID.ValueString())
+			if err != nil || data.Name.ValueString() == "" {
+				return nil, fmt.Errorf("the identity must set id, or integration_id and name")
+			}
+
+			return utils.FindResourceID(ctx, r.client, integrationID, nil, data.Name.ValueStringPointer())
+		},
+	})
 }
 
 // convertFullResourceResultResponseSchemaToModel is a utility function used to convert the API response data
@@ -999,6 +1123,11 @@ func convertFullResourceResultResponseSchemaToModel(
 		}
 	}
 
+	allowedDurationLabels, sDiag := utils.GetLabelSetFromAllowedDurations(data.AllowedDurations)
+	if sDiag.HasError() {
+		return ResourceResourceModel{}, sDiag
+	}
+
 	if diags.HasError() {
 		return ResourceResourceModel{}, diags
 	}
@@ -1186,6 +1315,7 @@ func convertFullResourceResultResponseSchemaToModel(
 		ExternalID:             utils.TrimmedStringValue(externalID),
 		Name:                   utils.TrimmedStringValue(data.Name),
 		AllowedDurations:       allowedDurations,
+		AllowedDurationLabels:  allowedDurationLabels,
 		Maintainers:            maintainers,
 		Tags:                   tags,
 		UserDefinedTags:        userDefinedTags,
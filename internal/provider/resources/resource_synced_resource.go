@@ -6,11 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,11 +28,14 @@ import (
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ResourceSyncedResource{}
 var _ resource.ResourceWithImportState = &ResourceSyncedResource{}
+var _ resource.ResourceWithIdentity = &ResourceSyncedResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceSyncedResource{}
 
 // NewResourceSyncedResource creates a new instance of the ResourceSyncedResource.
 func NewResourceSyncedResource() resource.Resource {
@@ -51,6 +54,7 @@ type createPlan struct {
 	// Remaining computed/optional fields accept unknown values via framework types.
 	ID                      types.String `tfsdk:"id"`
 	AllowedDurations        types.Set    `tfsdk:"allowed_durations"`
+	AllowedDurationLabels   types.Set    `tfsdk:"allowed_duration_labels"`
 	Workflow                types.Object `tfsdk:"workflow"`
 	Requestable             types.Bool   `tfsdk:"requestable"`
 	Owner                   types.Object `tfsdk:"owner"`
@@ -59,6 +63,14 @@ type createPlan struct {
 	UserDefinedTags         types.Set    `tfsdk:"user_defined_tags"`
 	UserDefinedDescription  types.String `tfsdk:"user_defined_description"`
 	PrerequisitePermissions types.List   `tfsdk:"prerequisite_permissions"`
+	RestoreOnDestroy        types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+// resourceSyncedModel is the state of an entitle_resource_synced, the state of
+// an entitle_resource and whether to restore its settings on destroy.
+type resourceSyncedModel struct {
+	ResourceResourceModel
+	RestoreOnDestroy types.Bool `tfsdk:"restore_on_destroy"`
 }
 
 // Metadata sets the metadata for the resource.
@@ -140,15 +152,19 @@ func (r *ResourceSyncedResource) Schema(ctx context.Context, req resource.Schema
 				ElementType:         types.NumberType,
 				Optional:            true,
 				Computed:            true,
-				Description:         "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
-				MarkdownDescription: "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
+				Description:         "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nConflicts with allowed_duration_labels.",
+				MarkdownDescription: "As the admin, you can set different durations for the resource, compared to the workflow linked to it.  \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nConflicts with `allowed_duration_labels`.",
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("allowed_duration_labels")),
+					validators.AllowedDurations{},
 				},
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
+					utils.AllowedDurationsFromLabels(),
 				},
 			},
+			"allowed_duration_labels": utils.AllowedDurationLabelsAttribute(),
 			"workflow": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"id": schema.StringAttribute{
@@ -197,8 +213,8 @@ func (r *ResourceSyncedResource) Schema(ctx context.Context, req resource.Schema
 					"email": schema.StringAttribute{
 						Optional:            true,
 						Computed:            true,
-						Description:         "The owner's email (lowercase) is used when id was not provided.",
-						MarkdownDescription: "The owner's email (lowercase) is used when id was not provided.",
+						Description:         "The owner's email (lowercase), resolved to the owner's id at plan time when id is not set.",
+						MarkdownDescription: "The owner's email (lowercase), resolved to the owner's `id` at plan time when `id` is not set.",
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
@@ -236,9 +252,14 @@ func (r *ResourceSyncedResource) Schema(ctx context.Context, req resource.Schema
 									},
 								},
 								"email": schema.StringAttribute{
+									Optional:            true,
 									Computed:            true,
-									Description:         "Maintainer's email.",
-									MarkdownDescription: "Maintainer's email.",
+									Description:         "Maintainer's email (lowercase), resolved to the user's or group's id at plan time when id is not set.",
+									MarkdownDescription: "Maintainer's email (lowercase), resolved to the user's or group's `id` at plan time when `id` is not set.",
+									Validators: []validator.String{
+										validators.Email{},
+										validators.Lowercase{},
+									},
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
@@ -377,6 +398,7 @@ func (r *ResourceSyncedResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"restore_on_destroy": utils.RestoreOnDestroyAttribute("resource"),
 		},
 	}
 }
@@ -399,6 +421,14 @@ func (r *ResourceSyncedResource) Configure(ctx context.Context, req resource.Con
 	r.client = c
 }
 
+// ModifyPlan resolves the owner and maintainers configured by email to their
+// IDs, so they are planned and stored in state along with the email, and
+// warns about the maintainers the plan removes.
+func (r *ResourceSyncedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	utils.ResolveOwnerAndMaintainers(ctx, r.client, req, resp)
+	utils.WarnMaintainersRemoved(ctx, req, resp, "entitle_resource_maintainer")
+}
+
 // Create handles the "creation" of an entitle_resource_synced resource.
 //
 // Since synced resources already exist in Entitle (managed by the integration),
@@ -416,7 +446,16 @@ func (r *ResourceSyncedResource) Create(ctx context.Context, req resource.Create
 	name := plan.Name.ValueStringPointer()
 	externalID := plan.ExternalID.ValueStringPointer()
 
-	resourceID, err := r.getResourceID(ctx, integrationID, externalID, name)
+	integrationUUID, err := uuid.Parse(integrationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse the integration id to uuid format",
+			fmt.Sprintf("received integration id: %s, error: %s", integrationID, err.Error()),
+		)
+		return
+	}
+
+	resourceID, err := utils.FindResourceID(ctx, r.client, integrationUUID, externalID, name)
 	if err != nil {
 		resp.Diagnostics.AddError("Resource not found", fmt.Sprintf(
 			"Failed to get the Resource by name (%s) or external id (%s) and integration (%s): %s",
@@ -434,7 +473,7 @@ func (r *ResourceSyncedResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -450,11 +489,19 @@ func (r *ResourceSyncedResource) Create(ctx context.Context, req resource.Create
 		)
 		return
 	}
-	state, diags := convertFullResourceResultResponseSchemaToModel(ctx, &apiResp.JSON200.Result)
+
+	// Keep the settings from before the adoption, to restore on destroy.
+	resp.Diagnostics.Append(saveResourceRestoreSnapshot(ctx, resp.Private, apiResp.JSON200.Result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := convertFullResourceResultResponseSchemaToModel(ctx, &apiResp.JSON200.Result)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	state := resourceSyncedModel{ResourceResourceModel: model, RestoreOnDestroy: plan.RestoreOnDestroy}
 	diags = resp.State.Set(ctx, &state)
 
 	r.compareAndUpdate(ctx, plan, apiResp.JSON200.Result, resp)
@@ -463,6 +510,7 @@ func (r *ResourceSyncedResource) Create(ctx context.Context, req resource.Create
 	}
 
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 func (r *ResourceSyncedResource) compareAndUpdate(ctx context.Context, plan createPlan, result client.IntegrationResourceResultSchema, resp *resource.CreateResponse) {
@@ -620,8 +668,12 @@ func (r *ResourceSyncedResource) compareAndUpdate(ctx context.Context, plan crea
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, resourceFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to update the resource: %s", err.Error()),
@@ -629,19 +681,20 @@ func (r *ResourceSyncedResource) compareAndUpdate(ctx context.Context, plan crea
 		return
 	}
 
-	state, diags := convertFullResourceResultResponseSchemaToModel(ctx, &apiResp.JSON200.Result)
+	model, diags := convertFullResourceResultResponseSchemaToModel(ctx, &apiResp.JSON200.Result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	state := resourceSyncedModel{ResourceResourceModel: model, RestoreOnDestroy: plan.RestoreOnDestroy}
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the current state of an entitle_resource_synced resource.
 func (r *ResourceSyncedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ResourceResourceModel
+	var data resourceSyncedModel
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -667,7 +720,7 @@ func (r *ResourceSyncedResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(resourceResp.HTTPResponse.StatusCode, resourceResp.Body)
+	err = utils.ResponseToError(resourceResp.HTTPResponse, resourceResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -690,20 +743,33 @@ func (r *ResourceSyncedResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	data, diags = convertFullResourceResultResponseSchemaToModel(ctx, &resourceResp.JSON200.Result)
+	// The first read after an import takes the snapshot of the settings to
+	// restore on destroy.
+	pending, diags := utils.IsRestorePending(ctx, req.Private)
 	resp.Diagnostics.Append(diags...)
+	if pending {
+		resp.Diagnostics.Append(saveResourceRestoreSnapshot(ctx, resp.Private, resourceResp.JSON200.Result)...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data.ResourceResourceModel, diags = convertFullResourceResultResponseSchemaToModel(ctx, &resourceResp.JSON200.Result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.RestoreOnDestroy = utils.RestoreOnDestroy(data.RestoreOnDestroy)
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update handles updates to an entitle_resource_synced resource.
 // Only mutable Entitle settings are updated; the underlying synced resource is not recreated.
 func (r *ResourceSyncedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ResourceResourceModel
+	var data resourceSyncedModel
 
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -839,7 +905,7 @@ func (r *ResourceSyncedResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	err = utils.HTTPResponseToError(resourceResp.HTTPResponse.StatusCode, resourceResp.Body)
+	err = utils.ResponseToError(resourceResp.HTTPResponse, resourceResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -847,6 +913,10 @@ func (r *ResourceSyncedResource) Update(ctx context.Context, req resource.Update
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, resourceFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to update the resource by the id (%s), %s", uid.String(), err.Error()),
@@ -854,7 +924,7 @@ func (r *ResourceSyncedResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	data, diags = convertFullResourceResultResponseSchemaToModel(ctx, &resourceResp.JSON200.Result)
+	data.ResourceResourceModel, diags = convertFullResourceResultResponseSchemaToModel(ctx, &resourceResp.JSON200.Result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -862,58 +932,133 @@ func (r *ResourceSyncedResource) Update(ctx context.Context, req resource.Update
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
-// Delete is a no-op for synced resources — Terraform state is removed but no DELETE
-// request is sent to Entitle, because synced resources are owned by the upstream integration.
+// Delete removes synced resources from Terraform state only — no DELETE request
+// is sent to Entitle, because synced resources are owned by the upstream integration.
+// With restore_on_destroy, the settings the resource had before Terraform adopted it
+// are sent back first.
 func (r *ResourceSyncedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Read state to satisfy the framework, then return without making any API call.
-	var data ResourceResourceModel
+	var data resourceSyncedModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-}
+	if resp.Diagnostics.HasError() || !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
 
-// ImportState imports an existing entitle_resource_synced by its UUID.
-func (r *ResourceSyncedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var request client.ResourcesUpdateJSONRequestBody
+	found, diags := utils.LoadRestoreSnapshot(ctx, req.Private, &request)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Nothing to Restore",
+			fmt.Sprintf("No settings were recorded when the resource (%s) was adopted, it is only removed from state.", data.ID.ValueString()),
+		)
+		return
+	}
+
+	uid, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to parse the resource id (%s) to UUID, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resourceResp, err := r.client.ResourcesUpdateWithResponse(ctx, uid, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to restore the resource by the id (%s), got error: %s", uid.String(), err),
+		)
+		return
+	}
+
+	err = utils.ResponseToError(resourceResp.HTTPResponse, resourceResp.Body)
+	if err != nil && !errors.Is(err, utils.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to restore the resource by the id (%s), %s", uid.String(), err.Error()),
+		)
+	}
 }
 
-// getResourceID paginates through ResourcesIndex to find a resource by exact name
-// within the given integrationId.
-func (r *ResourceSyncedResource) getResourceID(ctx context.Context, integrationID string, externalID, name *string) (*uuid.UUID, error) {
-	fetch := func(ctx context.Context, page int) ([]client.IntegrationResourceListItemResponseSchema, int, error) {
-		params := client.ResourcesIndexParams{
-			PerPage:       utils.IntPointer(100),
-			Page:          utils.IntPointer(page),
-			Search:        name,
-			IntegrationId: integrationID,
-			ExternalId:    externalID,
-		}
+// saveResourceRestoreSnapshot saves the requestable, workflow, allowed
+// durations, owner, maintainers and prerequisite permissions of a resource
+// into its private state, as the update request that restores them.
+//
+// A resource without a workflow or prerequisite permissions of its own is
+// saved with a null workflow and an empty list of prerequisite permissions, so
+// the restore clears the ones Terraform set.
+func saveResourceRestoreSnapshot(ctx context.Context, private utils.PrivateState, result client.IntegrationResourceResultSchema) diag.Diagnostics {
+	model, diags := convertFullResourceResultResponseSchemaToModel(ctx, &result)
+	if diags.HasError() {
+		return diags
+	}
 
-		resp, err := r.client.ResourcesIndexWithResponse(ctx, &params)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list resources: %w", err)
-		}
+	maintainers, err := buildUpdateMaintainers(ctx, model.Maintainers)
+	if err != nil {
+		diags.AddError("Client Error", err.Error())
+		return diags
+	}
 
-		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
-			return nil, 0, fmt.Errorf("API returned status %d while listing resources (page %d)",
-				resp.HTTPResponse.StatusCode, page)
-		}
+	prerequisites := make([][]client.IntegrationResourcesUpdateBodySchema_PrerequisitePermissions_Item, 0)
+	if result.PrerequisitePermissions != nil {
+		for _, group := range *result.PrerequisitePermissions {
+			items := make([]client.IntegrationResourcesUpdateBodySchema_PrerequisitePermissions_Item, 0, len(group))
+			for _, pp := range group {
+				v, err := pp.AsPrerequisiteRolePermissionResponseSchema()
+				if err != nil {
+					diags.AddError("Client Error", fmt.Sprintf("Failed to read the prerequisite permissions to restore, error: %v", err))
+					return diags
+				}
 
-		if resp.JSON200 == nil || resp.JSON200.Result == nil {
-			return nil, 0, fmt.Errorf("received invalid resource response structure (page %d)", page)
+				item := client.IntegrationResourcesUpdateBodySchema_PrerequisitePermissions_Item{}
+				err = item.MergePrerequisitePermissionCreateBodySchema(client.PrerequisitePermissionCreateBodySchema{
+					Default: v.Default,
+					Role: map[string]interface{}{
+						"id": v.Role.Id.String(),
+					},
+				})
+				if err != nil {
+					diags.AddError("Client Error", fmt.Sprintf("Failed to merge prerequisite permission data, error: %v", err))
+					return diags
+				}
+				items = append(items, item)
+			}
+			prerequisites = append(prerequisites, items)
 		}
-
-		items := resp.JSON200.Result
-		total := int(resp.JSON200.Pagination.TotalPages)
-		return items, total, nil
 	}
 
-	if externalID != nil && *externalID != "" {
-		return utils.FindIDByExternalID(ctx, *externalID, fetch)
+	request := client.ResourcesUpdateJSONRequestBody{
+		Requestable:             &result.Requestable,
+		AllowedDurations:        &result.AllowedDurations,
+		Maintainers:             &maintainers,
+		PrerequisitePermissions: &prerequisites,
+	}
+	if result.Workflow != nil {
+		request.Workflow = &client.IdParamsSchema{Id: result.Workflow.Id}
 	}
-	if name != nil && *name != "" {
-		return utils.FindIDByName(ctx, *name, fetch)
+	if result.Owner != nil {
+		request.Owner = &client.UserEntitySchema{Id: result.Owner.Id.String()}
 	}
 
-	return nil, fmt.Errorf("name or externalId must be set")
+	diags.Append(utils.SaveRestoreSnapshot(ctx, private, request)...)
+
+	return diags
+}
+
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *ResourceSyncedResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+// ImportState imports an existing entitle_resource_synced by its UUID.
+func (r *ResourceSyncedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(utils.MarkRestorePending(ctx, resp.Private)...)
 }
@@ -157,7 +157,7 @@ func (d *ResourcesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	if err := utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body); err != nil {
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to list resources: %s", err))
@@ -0,0 +1,792 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourcesSyncedSetResource{}
+var _ resource.ResourceWithModifyPlan = &ResourcesSyncedSetResource{}
+
+// NewResourcesSyncedSetResource creates a new instance of the ResourcesSyncedSetResource.
+func NewResourcesSyncedSetResource() resource.Resource {
+	return &ResourcesSyncedSetResource{}
+}
+
+// ResourcesSyncedSetResource defines the resource implementation. It applies
+// common settings to the synced resources of an integration that match a
+// selector.
+type ResourcesSyncedSetResource struct {
+	client *client.ClientWithResponses
+}
+
+// ResourcesSyncedSetResourceModel describes the resource data model.
+type ResourcesSyncedSetResourceModel struct {
+	ID              types.String             `tfsdk:"id"`
+	IntegrationID   types.String             `tfsdk:"integration_id"`
+	NameRegex       types.String             `tfsdk:"name_regex"`
+	Tags            types.Set                `tfsdk:"tags"`
+	Requestable     types.Bool               `tfsdk:"requestable"`
+	Owner           types.Object             `tfsdk:"owner"`
+	Maintainers     types.Set                `tfsdk:"maintainers"`
+	Workflow        *utils.IdentityOnlyModel `tfsdk:"workflow"`
+	UserDefinedTags types.Set                `tfsdk:"user_defined_tags"`
+	Resources       types.Map                `tfsdk:"resources"`
+}
+
+// Metadata sets the metadata for the resource.
+func (r *ResourcesSyncedSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources_synced_set"
+}
+
+// Schema sets the schema for the resource.
+func (r *ResourcesSyncedSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.ResourcesSyncedSetResourceMarkdownDescription,
+		Description:         "Applies common Entitle settings to the connector-synced resources of an integration that match a selector.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the set in UUID format, generated by the provider.",
+				MarkdownDescription: "Identifier of the set in UUID format, generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "Select the resources of this integration.",
+				MarkdownDescription: "Select the resources of this integration.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Select the resources whose name matches this regular expression (RE2 syntax).",
+				MarkdownDescription: "Select the resources whose name matches this regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)).",
+				Validators: []validator.String{
+					validators.Regex{},
+					stringvalidator.AtLeastOneOf(path.MatchRoot("name_regex"), path.MatchRoot("tags")),
+				},
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Select the resources that have all of these system-generated tags. Combined with name_regex, a resource must match both.",
+				MarkdownDescription: "Select the resources that have all of these system-generated tags. Combined with `name_regex`, a resource must match both.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"requestable": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the selected resources are requestable. Left unchanged when not set.",
+				MarkdownDescription: "Whether the selected resources are requestable. Left unchanged when not set.",
+			},
+			"owner": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The owner's id.",
+						MarkdownDescription: "The owner's id.",
+						Validators: []validator.String{
+							validators.UUID{},
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+						},
+					},
+					"email": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The owner's email (lowercase), resolved to the owner's id at plan time when id is not set.",
+						MarkdownDescription: "The owner's email (lowercase), resolved to the owner's `id` at plan time when `id` is not set.",
+						Validators: []validator.String{
+							validators.Email{},
+							validators.Lowercase{},
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The owner of the selected resources. Left unchanged when not set.",
+				MarkdownDescription: "The owner of the selected resources. Left unchanged when not set.",
+			},
+			"maintainers": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							Description:         "\"user\" or \"group\"",
+							MarkdownDescription: "\"user\" or \"group\"",
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.MaintainerTypeUser, utils.MaintainerTypeGroup),
+							},
+						},
+						"entity": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Optional:            true,
+									Computed:            true,
+									Description:         "Maintainer's unique identifier.",
+									MarkdownDescription: "Maintainer's unique identifier.",
+									Validators: []validator.String{
+										validators.UUID{},
+										stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+									},
+								},
+								"email": schema.StringAttribute{
+									Optional:            true,
+									Description:         "Maintainer's email (lowercase), resolved to the user's or group's id at plan time when id is not set.",
+									MarkdownDescription: "Maintainer's email (lowercase), resolved to the user's or group's `id` at plan time when `id` is not set.",
+									Validators: []validator.String{
+										validators.Email{},
+										validators.Lowercase{},
+									},
+								},
+							},
+							Required:            true,
+							Description:         "Maintainer's entity.",
+							MarkdownDescription: "Maintainer's entity.",
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The maintainers of the selected resources, replacing their current maintainers. Left unchanged when not set.",
+				MarkdownDescription: "The maintainers of the selected resources, replacing their current maintainers. Left unchanged when not set.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"workflow": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						Description:         "The workflow's id.",
+						MarkdownDescription: "The workflow's id.",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The approval workflow of the selected resources. Left unchanged when not set.",
+				MarkdownDescription: "The approval workflow of the selected resources. Left unchanged when not set.",
+			},
+			"user_defined_tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The user-defined tags of the selected resources, replacing their current ones. Left unchanged when not set.",
+				MarkdownDescription: "The user-defined tags of the selected resources, replacing their current ones. Left unchanged when not set.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"resources": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The selected resources, their names by their ids. Planned from the resources matching the selector, so the plan shows the resources joining and leaving the set.",
+				MarkdownDescription: "The selected resources, their names by their ids. Planned from the resources matching the selector, so the plan shows the resources joining and leaving the set.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *ResourcesSyncedSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan resolves the owner and maintainers configured by email, and
+// plans the resources from the ones currently matching the selector.
+func (r *ResourcesSyncedSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy, or before the provider is configured.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	utils.ResolveOwnerAndMaintainers(ctx, r.client, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ResourcesSyncedSetResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IntegrationID.IsUnknown() || plan.NameRegex.IsUnknown() || plan.Tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resources"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	matched, err := r.matchResources(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to list the resources: %s", err))
+		return
+	}
+
+	resources, diags := resourceNames(matched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resources"), resources)...)
+}
+
+// Create applies the settings to the planned resources.
+func (r *ResourcesSyncedSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, diags := r.resolvePlan(ctx, req.Config, resp.State, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	resources, diags := r.apply(ctx, &plan, types.MapNull(types.StringType), true)
+	resp.Diagnostics.Append(diags...)
+	if resources.IsNull() {
+		return
+	}
+
+	// The resources updated are stored even when others failed. Terraform
+	// taints a set whose create failed, so the next apply replaces it, which
+	// sends the settings to every resource of the set again.
+	plan.Resources = resources
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resources of the set. Resources that no longer exist,
+// and resources whose settings were changed outside of Terraform, are
+// removed, so the next plan applies the settings to them again. Whether they
+// still match the selector is checked at plan time.
+//
+// The resource listing has none of the settings, so checking them fetches
+// each resource of the set: one request per resource on every refresh. A set
+// that configures no settings has nothing to check and skips the fetches.
+func (r *ResourcesSyncedSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourcesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listed, err := r.listResources(ctx, data.IntegrationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to list the resources: %s", err))
+		return
+	}
+
+	applied := data.Resources.Elements()
+	listed = slices.DeleteFunc(listed, func(res client.IntegrationResourceListItemResponseSchema) bool {
+		_, ok := applied[res.Id.String()]
+		return !ok
+	})
+
+	if !hasConfiguredSettings(data) {
+		var diags diag.Diagnostics
+		data.Resources, diags = resourceNames(listed)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	details, err := r.resourceDetails(ctx, listed)
+	if err != nil {
+		resp.Diagnostics.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to get the resources: %s", err))
+		return
+	}
+
+	var kept []client.IntegrationResourceListItemResponseSchema
+	for i, res := range listed {
+		ok, err := hasSettings(ctx, data, details[i])
+		if err != nil {
+			resp.Diagnostics.AddError(utils.ErrApiResponse.Error(), fmt.Sprintf("Failed to read the settings of the resource %s: %s", res.Id, err))
+			return
+		}
+		if ok {
+			kept = append(kept, res)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Resources, diags = resourceNames(kept)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An owner configured by id has no email in state until a resource of the
+	// set with that owner is read.
+	for _, res := range details {
+		data.Owner, diags = ownerWithEmail(ctx, data.Owner, res.Owner)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies the settings to the planned resources. When the settings are
+// unchanged, only the resources that joined the set are updated.
+func (r *ResourcesSyncedSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state ResourcesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	plan, diags := r.resolvePlan(ctx, req.Config, req.State, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources, diags := r.apply(ctx, &plan, state.Resources, !sameSettings(plan, state))
+	resp.Diagnostics.Append(diags...)
+	if resources.IsNull() {
+		return
+	}
+
+	// The resources updated are stored even when others failed, so the next
+	// apply updates the failed ones only.
+	plan.Resources = resources
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the set from Terraform state only. The resources are owned
+// by the upstream integration and keep their settings.
+func (r *ResourcesSyncedSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourcesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+}
+
+// resolvePlan returns the plan, with the owner and maintainers configured by
+// an email that was unknown at plan time resolved to their ids.
+func (r *ResourcesSyncedSetResource) resolvePlan(ctx context.Context, config tfsdk.Config, state tfsdk.State, plan tfsdk.Plan) (ResourcesSyncedSetResourceModel, diag.Diagnostics) {
+	var data ResourcesSyncedSetResourceModel
+
+	diags := utils.ResolveOwner(ctx, r.client, path.Root("owner"), config, state, &plan)
+	diags.Append(utils.ResolveMaintainers(ctx, r.client, path.Root("maintainers"), config, &plan)...)
+	if diags.HasError() {
+		return data, diags
+	}
+
+	diags.Append(plan.Get(ctx, &data)...)
+
+	return data, diags
+}
+
+// apply sends the settings of the plan to its resources, listing them when
+// they were unknown at plan time, and returns the resources of the set once
+// applied. The resources of prior are skipped unless settingsChanged. The
+// email of an owner configured by id is set from the updated resources. The
+// resources are null when none could be updated.
+func (r *ResourcesSyncedSetResource) apply(ctx context.Context, plan *ResourcesSyncedSetResourceModel, prior types.Map, settingsChanged bool) (types.Map, diag.Diagnostics) {
+	request, diags := resourcesSyncedSetRequest(ctx, *plan)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	planned := plan.Resources
+	if planned.IsUnknown() {
+		matched, err := r.matchResources(ctx, *plan)
+		if err != nil {
+			diags.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to list the resources: %s", err))
+			return types.MapNull(types.StringType), diags
+		}
+
+		var d diag.Diagnostics
+		planned, d = resourceNames(matched)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+	}
+
+	var mu sync.Mutex
+	var owner *client.EntityResponseSchema
+	resources, d := utils.ApplySyncedSet(ctx, "resource", planned, prior, settingsChanged, func(ctx context.Context, id uuid.UUID) error {
+		apiResp, err := r.client.ResourcesUpdateWithBodyWithResponse(ctx, id, "application/json", bytes.NewReader(request))
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		owner = apiResp.JSON200.Result.Owner
+
+		return nil
+	})
+	diags.Append(d...)
+
+	plan.Owner, d = ownerWithEmail(ctx, plan.Owner, owner)
+	diags.Append(d...)
+
+	// An owner email left unknown, when no resource of the set was updated,
+	// is read from the resources on the next refresh.
+	if email, ok := plan.Owner.Attributes()["email"]; ok && email.IsUnknown() {
+		plan.Owner, d = types.ObjectValue(plan.Owner.AttributeTypes(ctx), map[string]attr.Value{
+			"id":    plan.Owner.Attributes()["id"],
+			"email": types.StringNull(),
+		})
+		diags.Append(d...)
+	}
+
+	return resources, diags
+}
+
+// resourcesSyncedSetRequest builds the JSON body of the update of the
+// settings of the set. The settings that are not configured are sent as null
+// when they are part of a generated update body, which clears them, so the
+// body has the configured settings only.
+func resourcesSyncedSetRequest(ctx context.Context, plan ResourcesSyncedSetResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := map[string]any{}
+
+	if !plan.Requestable.IsNull() {
+		request["requestable"] = plan.Requestable.ValueBool()
+	}
+
+	if plan.Workflow != nil {
+		workflowID, err := uuid.Parse(plan.Workflow.Id.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Failed to parse the workflow id to UUID: %v", err))
+			return nil, diags
+		}
+		request["workflow"] = client.IdParamsSchema{Id: workflowID}
+	}
+
+	if !plan.Owner.IsNull() {
+		id, _ := plan.Owner.Attributes()["id"].(types.String)
+		request["owner"] = client.UserEntitySchema{Id: id.ValueString()}
+	}
+
+	if !plan.Maintainers.IsNull() {
+		var maintainerModels []utils.MaintainerModel
+		diags.Append(plan.Maintainers.ElementsAs(ctx, &maintainerModels, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		maintainers, err := buildUpdateMaintainers(ctx, maintainerModels)
+		if err != nil {
+			diags.AddError("Client Error", err.Error())
+			return nil, diags
+		}
+		request["maintainers"] = maintainers
+	}
+
+	if !plan.UserDefinedTags.IsNull() {
+		var tags []string
+		diags.Append(plan.UserDefinedTags.ElementsAs(ctx, &tags, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		request["userDefinedTags"] = tags
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to encode the resource settings, error: %v", err))
+		return nil, diags
+	}
+
+	return body, diags
+}
+
+// sameSettings reports whether two models of the set have the same settings.
+func sameSettings(a, b ResourcesSyncedSetResourceModel) bool {
+	sameWorkflow := (a.Workflow == nil) == (b.Workflow == nil) &&
+		(a.Workflow == nil || a.Workflow.Id.Equal(b.Workflow.Id))
+
+	return sameWorkflow &&
+		a.Requestable.Equal(b.Requestable) &&
+		ownerID(a.Owner).Equal(ownerID(b.Owner)) &&
+		a.Maintainers.Equal(b.Maintainers) &&
+		a.UserDefinedTags.Equal(b.UserDefinedTags)
+}
+
+// hasConfiguredSettings reports whether the set configures any of the
+// settings it applies to its resources.
+func hasConfiguredSettings(data ResourcesSyncedSetResourceModel) bool {
+	return !data.Requestable.IsNull() ||
+		data.Workflow != nil ||
+		!ownerID(data.Owner).IsNull() ||
+		!data.Maintainers.IsNull() ||
+		!data.UserDefinedTags.IsNull()
+}
+
+// hasSettings reports whether a resource still has the settings of the set.
+func hasSettings(ctx context.Context, data ResourcesSyncedSetResourceModel, res client.IntegrationResourceResultSchema) (bool, error) {
+	if !data.Requestable.IsNull() && res.Requestable != data.Requestable.ValueBool() {
+		return false, nil
+	}
+
+	if data.Workflow != nil && (res.Workflow == nil || res.Workflow.Id.String() != data.Workflow.Id.ValueString()) {
+		return false, nil
+	}
+
+	if id := ownerID(data.Owner); !id.IsNull() && (res.Owner == nil || !strings.EqualFold(res.Owner.Id.String(), id.ValueString())) {
+		return false, nil
+	}
+
+	if !data.Maintainers.IsNull() {
+		maintainers, err := utils.ParseMaintainers(res.Maintainers)
+		if err != nil {
+			return false, err
+		}
+
+		if len(maintainers) != len(data.Maintainers.Elements()) {
+			return false, nil
+		}
+
+		var models []utils.MaintainerModel
+		if diags := data.Maintainers.ElementsAs(ctx, &models, false); diags.HasError() {
+			return false, fmt.Errorf("failed to read the maintainers of the set")
+		}
+		for _, m := range models {
+			id, _ := m.Entity.Attributes()["id"].(types.String)
+			if !utils.HasMaintainer(maintainers, utils.Maintainer{Type: m.Type.ValueString(), ID: id.ValueString()}) {
+				return false, nil
+			}
+		}
+	}
+
+	if !data.UserDefinedTags.IsNull() {
+		var tags []string
+		if res.UserDefinedTags != nil {
+			tags = *res.UserDefinedTags
+		}
+
+		if len(tags) != len(data.UserDefinedTags.Elements()) {
+			return false, nil
+		}
+		for _, tag := range data.UserDefinedTags.Elements() {
+			if tag, ok := tag.(types.String); !ok || !slices.Contains(tags, tag.ValueString()) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// ownerID returns the id of the owner of the set, null when it has none.
+func ownerID(owner types.Object) types.String {
+	if owner.IsNull() || owner.IsUnknown() {
+		return types.StringNull()
+	}
+
+	id, _ := owner.Attributes()["id"].(types.String)
+
+	return id
+}
+
+// ownerWithEmail returns the owner of the set with the email of the owner of
+// a resource, when the email of the set is unknown or null and the resource
+// has the owner of the set.
+func ownerWithEmail(ctx context.Context, owner types.Object, resOwner *client.EntityResponseSchema) (types.Object, diag.Diagnostics) {
+	if resOwner == nil || resOwner.Email == nil || owner.IsNull() || owner.IsUnknown() {
+		return owner, nil
+	}
+
+	attributes := owner.Attributes()
+	email, _ := attributes["email"].(types.String)
+	if !email.IsUnknown() && !email.IsNull() {
+		return owner, nil
+	}
+
+	if id := ownerID(owner); !strings.EqualFold(id.ValueString(), resOwner.Id.String()) {
+		return owner, nil
+	}
+
+	return types.ObjectValue(owner.AttributeTypes(ctx), map[string]attr.Value{
+		"id":    attributes["id"],
+		"email": types.StringValue(strings.ToLower(string(*resOwner.Email))),
+	})
+}
+
+// listResources lists the synced resources of an integration. The listing is
+// kept in the lookup cache of the provider process until a resource is
+// written, so refresh and plan list the resources once.
+func (r *ResourcesSyncedSetResource) listResources(ctx context.Context, integrationID string) ([]client.IntegrationResourceListItemResponseSchema, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	resources, err := utils.AllPages(ctx, func(ctx context.Context, page int) ([]client.IntegrationResourceListItemResponseSchema, int, error) {
+		resp, err := r.client.ResourcesIndexWithResponse(ctx, &client.ResourcesIndexParams{
+			Page:          utils.IntPointer(page),
+			PerPage:       utils.IntPointer(100),
+			IntegrationId: integrationID,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list resources: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing resources (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid resource response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(resources, func(res client.IntegrationResourceListItemResponseSchema) bool {
+		return !utils.IsApplicationWithSyncedResources(res.Integration.Application.Name)
+	}), nil
+}
+
+// matchResources returns the synced resources of the integration of the set
+// that its selector matches, sorted by id. The resource listing has no tags,
+// so selecting by tags fetches each resource left after the name_regex
+// filter.
+func (r *ResourcesSyncedSetResource) matchResources(ctx context.Context, data ResourcesSyncedSetResourceModel) ([]client.IntegrationResourceListItemResponseSchema, error) {
+	resources, err := r.listResources(ctx, data.IntegrationID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex: %w", err)
+		}
+
+		resources = slices.DeleteFunc(resources, func(res client.IntegrationResourceListItemResponseSchema) bool {
+			return !re.MatchString(res.Name)
+		})
+	}
+
+	if !data.Tags.IsNull() {
+		var tags []string
+		for _, tag := range data.Tags.Elements() {
+			if tag, ok := tag.(types.String); ok {
+				tags = append(tags, tag.ValueString())
+			}
+		}
+
+		details, err := r.resourceDetails(ctx, resources)
+		if err != nil {
+			return nil, err
+		}
+
+		var matched []client.IntegrationResourceListItemResponseSchema
+		for i, res := range resources {
+			if hasAllTags(details[i].Tags, tags) {
+				matched = append(matched, res)
+			}
+		}
+		resources = matched
+	}
+
+	slices.SortFunc(resources, func(a, b client.IntegrationResourceListItemResponseSchema) int {
+		return strings.Compare(a.Id.String(), b.Id.String())
+	})
+
+	return resources, nil
+}
+
+// resourceDetails fetches the resources concurrently. The responses are kept
+// in the lookup cache of the provider process until a resource is written,
+// so refresh and plan fetch each resource once.
+func (r *ResourcesSyncedSetResource) resourceDetails(ctx context.Context, resources []client.IntegrationResourceListItemResponseSchema) ([]client.IntegrationResourceResultSchema, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	return utils.MapConcurrently(ctx, resources, func(ctx context.Context, res client.IntegrationResourceListItemResponseSchema) (client.IntegrationResourceResultSchema, error) {
+		resp, err := r.client.ResourcesShowWithResponse(ctx, res.Id)
+		if err != nil {
+			return client.IntegrationResourceResultSchema{}, fmt.Errorf("failed to get the resource %s: %w", res.Id, err)
+		}
+
+		if err := utils.ResponseToError(resp.HTTPResponse, resp.Body); err != nil {
+			return client.IntegrationResourceResultSchema{}, fmt.Errorf("failed to get the resource %s: %w", res.Id, err)
+		}
+
+		return resp.JSON200.Result, nil
+	})
+}
+
+// hasAllTags reports whether tags contains every one of want.
+func hasAllTags(tags *[]string, want []string) bool {
+	if tags == nil {
+		return len(want) == 0
+	}
+
+	for _, tag := range want {
+		if !slices.Contains(*tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceNames returns the names of resources by their ids.
+func resourceNames(resources []client.IntegrationResourceListItemResponseSchema) (types.Map, diag.Diagnostics) {
+	names := make(map[string]attr.Value, len(resources))
+	for _, res := range resources {
+		names[res.Id.String()] = types.StringValue(res.Name)
+	}
+
+	return types.MapValue(types.StringType, names)
+}
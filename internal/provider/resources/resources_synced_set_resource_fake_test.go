@@ -0,0 +1,111 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestResourcesSyncedSetResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	owner := srv.AddUser("owner@example.com")
+	maintainer := srv.AddUser("maintainer@example.com")
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "GitHub",
+		"application": map[string]any{"name": "github"},
+	})
+	workflowID := srv.Add(fakeentitle.Workflows, map[string]any{"name": "Synced Workflow"})
+	resourceID := func(name string) string {
+		return srv.Add(fakeentitle.Resources, map[string]any{
+			"name":             name,
+			"integration":      map[string]any{"id": integrationID},
+			"requestable":      false,
+			"workflow":         map[string]any{"id": workflowID},
+			"owner":            map[string]any{"id": owner.ID},
+			"maintainers":      []any{map[string]any{"type": "user", "user": map[string]any{"id": maintainer.ID}}},
+			"allowedDurations": []any{float64(3600)},
+		})
+	}
+	repoID, infraID := resourceID("repo-app"), resourceID("infra")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing: the settings that are not configured
+			// keep their synced values.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_resources_synced_set" "my_resources" {
+	integration_id = "%s"
+	name_regex     = "^repo-"
+	requestable    = true
+}
+`, integrationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "resources.%", "1"),
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "resources."+repoID, "repo-app"),
+					checkResource(srv, repoID, true, workflowID, owner.ID, maintainer.ID),
+					checkResource(srv, infraID, false, workflowID, owner.ID, maintainer.ID),
+				),
+			},
+			// Update testing: resources matching the new selector join the set.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_resources_synced_set" "my_resources" {
+	integration_id = "%s"
+	name_regex     = ".*"
+	requestable    = true
+}
+`, integrationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "resources.%", "2"),
+					checkResource(srv, infraID, true, workflowID, owner.ID, maintainer.ID),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// checkResource checks the requestable of a resource in the fake, and that
+// its workflow, owner, maintainers and allowed durations were kept.
+func checkResource(srv *fakeentitle.Server, id string, requestable bool, workflowID, ownerID, maintainerID string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		res, ok := srv.Get(fakeentitle.Resources, id)
+		if !ok {
+			return fmt.Errorf("resource %s not found", id)
+		}
+
+		if res["requestable"] != requestable {
+			return fmt.Errorf("expected resource %s requestable to be %t, got %v", id, requestable, res["requestable"])
+		}
+
+		if workflow, _ := res["workflow"].(map[string]any); workflow == nil || workflow["id"] != workflowID {
+			return fmt.Errorf("expected resource %s workflow to be kept, got %v", id, res["workflow"])
+		}
+
+		if owner, _ := res["owner"].(map[string]any); owner == nil || owner["id"] != ownerID {
+			return fmt.Errorf("expected resource %s owner to be kept, got %v", id, res["owner"])
+		}
+
+		maintainers, _ := res["maintainers"].([]any)
+		if len(maintainers) != 1 {
+			return fmt.Errorf("expected resource %s maintainers to be kept, got %v", id, res["maintainers"])
+		}
+		if user, _ := maintainers[0].(map[string]any)["user"].(map[string]any); user == nil || user["id"] != maintainerID {
+			return fmt.Errorf("expected resource %s maintainers to be kept, got %v", id, res["maintainers"])
+		}
+
+		if durations, _ := res["allowedDurations"].([]any); len(durations) != 1 || durations[0] != float64(3600) {
+			return fmt.Errorf("expected resource %s allowed durations to be kept, got %v", id, res["allowedDurations"])
+		}
+
+		return nil
+	}
+}
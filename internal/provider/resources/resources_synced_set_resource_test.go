@@ -0,0 +1,62 @@
+//go:build acceptance
+
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestResourcesSyncedSetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_resources_synced_set" "my_resources" {
+	integration_id = "%s"
+	name_regex     = "^%s$"
+	requestable    = false
+}
+`, os.Getenv("ENTITLE_INTEGRATION_ID"), regexp.QuoteMeta(os.Getenv("ENTITLE_RESOURCE_SYNCED_NAME"))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "integration_id", os.Getenv("ENTITLE_INTEGRATION_ID")),
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "requestable", "false"),
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "resources.%", "1"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_resources_synced_set.my_resources", "id"),
+				),
+			},
+			// Update testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_resources_synced_set" "my_resources" {
+	integration_id = "%s"
+	name_regex     = "^%s$"
+	requestable    = true
+
+	owner = {
+		id = "%s"
+	}
+}
+`, os.Getenv("ENTITLE_INTEGRATION_ID"), regexp.QuoteMeta(os.Getenv("ENTITLE_RESOURCE_SYNCED_NAME")), os.Getenv("ENTITLE_OWNER_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "requestable", "true"),
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "owner.id", os.Getenv("ENTITLE_OWNER_ID")),
+					resource.TestCheckResourceAttr("entitle_resources_synced_set.my_resources", "resources.%", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
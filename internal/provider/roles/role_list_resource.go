@@ -0,0 +1,162 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &RoleListResource{}
+var _ list.ListResourceWithConfigure = &RoleListResource{}
+
+// NewRoleListResource creates a new instance of the RoleListResource.
+func NewRoleListResource() list.ListResource {
+	return &RoleListResource{}
+}
+
+// RoleListResource lists roles for Terraform query.
+type RoleListResource struct {
+	client *client.ClientWithResponses
+}
+
+// RoleListResourceModel describes the list resource configuration.
+type RoleListResourceModel struct {
+	IntegrationID types.String `tfsdk:"integration_id"`
+	ResourceID    types.String `tfsdk:"resource_id"`
+	Search        types.String `tfsdk:"search"`
+}
+
+// Metadata sets the metadata for the list resource, which lists entitle_role resources.
+func (r *RoleListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *RoleListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.RoleListResourceMarkdownDescription,
+		Attributes: map[string]listschema.Attribute{
+			"integration_id": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of an integration to list the roles of.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+			},
+			"resource_id": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of a resource to list the roles of.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+			},
+			"search": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Search string to filter roles by name.",
+			},
+		},
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *RoleListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each role matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *RoleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data RoleListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	integrationID, err := optionalUUID(data.IntegrationID)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(path.Root("integration_id"), "Invalid Integration ID", err.Error()),
+		})
+		return
+	}
+
+	resourceID, err := optionalUUID(data.ResourceID)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(path.Root("resource_id"), "Invalid Resource ID", err.Error()),
+		})
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, roleIdentityPaths,
+		func(ctx context.Context, page int) ([]client.IntegrationResourceRoleListItemResponseSchema, int, error) {
+			apiResp, err := r.client.RolesIndexWithResponse(ctx, &client.RolesIndexParams{
+				Page:          utils.IntPointer(page),
+				PerPage:       utils.IntPointer(100),
+				Search:        data.Search.ValueStringPointer(),
+				IntegrationId: integrationID,
+				ResourceId:    resourceID,
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, role client.IntegrationResourceRoleListItemResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), role.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("name"), role.Name)...)
+			diags.Append(res.SetAttribute(ctx, path.Root("resource").AtName("id"), role.Resource.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("resource").AtName("name"), role.Resource.Name)...)
+			return fmt.Sprintf("%s/%s/%s", role.Resource.Integration.Name, role.Resource.Name, role.Name), diags
+		},
+	)
+}
+
+// optionalUUID parses an optional UUID attribute, returning nil when it is not set.
+func optionalUUID(v types.String) (*uuid.UUID, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(v.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
@@ -13,22 +13,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RoleResource{}
 var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithIdentity = &RoleResource{}
+
+// roleIdentityPaths maps the role identity attributes to their state attributes.
+var roleIdentityPaths = utils.IdentityPaths{
+	"id":          path.Root("id"),
+	"resource_id": path.Root("resource").AtName("id"),
+	"name":        path.Root("name"),
+}
+
+// roleIdentityModel is the identity of a role: its ID, or its resource's ID and its name.
+type roleIdentityModel struct {
+	ID         types.String `tfsdk:"id"`
+	ResourceID types.String `tfsdk:"resource_id"`
+	Name       types.String `tfsdk:"name"`
+}
+
+// roleFieldPaths maps role request body fields to their attributes.
+var roleFieldPaths = utils.APIFieldPaths{
+	"name":                    path.Root("name"),
+	"resource":                path.Root("resource"),
+	"requestable":             path.Root("requestable"),
+	"workflow":                path.Root("workflow"),
+	"allowedDurations":        path.Root("allowed_durations"),
+	"prerequisitePermissions": path.Root("prerequisite_permissions"),
+	"virtualizedRole":         path.Root("virtualized_role"),
+}
 
 // NewRoleResource creates a new instance of the RoleResource.
 func NewRoleResource() resource.Resource {
@@ -47,6 +77,7 @@ type RoleResourceModel struct {
 	ExternalID              types.String                        `tfsdk:"external_id"`
 	Resource                *utils.IdNameModel                  `tfsdk:"resource" json:"resource"`
 	AllowedDurations        types.Set                           `tfsdk:"allowed_durations"`
+	AllowedDurationLabels   types.Set                           `tfsdk:"allowed_duration_labels"`
 	Workflow                *utils.IdNameModel                  `tfsdk:"workflow"`
 	PrerequisitePermissions []utils.PrerequisitePermissionModel `tfsdk:"prerequisite_permissions"`
 	VirtualizedRole         *utils.IdNameModel                  `tfsdk:"virtualized_role"`
@@ -56,6 +87,8 @@ type RoleResourceModel struct {
 // Metadata sets the metadata for the resource.
 func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role"
+	// The identity includes the role's name, which can be changed.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema sets the schema for the resource.
@@ -106,13 +139,21 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			},
 			"allowed_durations": schema.SetAttribute{
 				ElementType:         types.NumberType,
-				Required:            true,
-				Description:         "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
-				MarkdownDescription: "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
+				Optional:            true,
+				Computed:            true,
+				Description:         "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nExactly one of allowed_durations and allowed_duration_labels must be set.",
+				MarkdownDescription: "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nExactly one of `allowed_durations` and `allowed_duration_labels` must be set.",
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(path.MatchRoot("allowed_duration_labels")),
+					validators.AllowedDurations{},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					utils.AllowedDurationsFromLabels(),
 				},
 			},
+			"allowed_duration_labels": utils.AllowedDurationLabelsAttribute(),
 			"workflow": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					// Attribute: workflow id
@@ -372,8 +413,12 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, roleFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -399,6 +444,8 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, roleIdentityPaths)...)
 }
 
 // Read retrieves an existing resource of type Entitle Role.
@@ -436,7 +483,7 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -476,6 +523,8 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, roleIdentityPaths)...)
 }
 
 // Update handles updates to an existing resource of type Entitle Role.
@@ -571,7 +620,7 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -580,6 +629,10 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, roleFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -603,6 +656,8 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, roleIdentityPaths)...)
 }
 
 // Delete is responsible for deleting an existing resource of type Entitle Role.
@@ -642,7 +697,7 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -652,12 +707,70 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// IdentitySchema defines the identity of the role: its Entitle ID, or its
+// resource's ID and its name.
+func (r *RoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The Entitle ID (UUID) of the role.",
+			},
+			"resource_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The ID of the role's resource. Used with name to import the role when id is not set.",
+			},
+			"name": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The name of the role. Used with resource_id to import the role when id is not set.",
+			},
+		},
+	}
+}
+
 // ImportState is used to import an existing resource's state into Terraform.
 //
-// It extracts the resource's identifier from the import request and sets
-// it in Terraform state using resource.ImportStatePassthroughID.
+// The import ID is the role's UUID, external:<resource_id>/<external_id>, or
+// integration_name/resource_name/role_name.
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateByID(ctx, req, resp, utils.ImportResolvers{
+		ExternalID: func(ctx context.Context, resourceID uuid.UUID, externalID string) (*uuid.UUID, error) {
+			return utils.FindRoleID(ctx, r.client, resourceID, &externalID, nil)
+		},
+		ExternalIDParent: "resource_id",
+		Path: func(ctx context.Context, names []string) (*uuid.UUID, error) {
+			integrationID, err := utils.FindIntegrationIDByName(ctx, r.client, names[0])
+			if err != nil {
+				return nil, fmt.Errorf("integration %q: %w", names[0], err)
+			}
+
+			resourceID, err := utils.FindResourceID(ctx, r.client, *integrationID, nil, &names[1])
+			if err != nil {
+				return nil, fmt.Errorf("resource %q: %w", names[1], err)
+			}
+
+			roleID, err := utils.FindRoleID(ctx, r.client, *resourceID, nil, &names[2])
+			if err != nil {
+				return nil, fmt.Errorf("role %q: %w", names[2], err)
+			}
+
+			return roleID, nil
+		},
+		PathFormat: "<integration_name>/<resource_name>/<role_name>",
+		Identity: func(ctx context.Context, identity *tfsdk.ResourceIdentity) (*uuid.UUID, error) {
+			var data roleIdentityModel
+			if diags := identity.Get(ctx, &data); diags.HasError() {
+				return nil, fmt.Errorf("failed to read the import identity")
+			}
+
+			resourceID, err := uuid.Parse(data.ResourceID.ValueString())
+			if err != nil || data.Name.ValueString() == "" {
+				return nil, fmt.Errorf("the identity must set id, or resource_id and name")
+			}
+
+			return utils.FindRoleID(ctx, r.client, resourceID, nil, data.Name.ValueStringPointer())
+		},
+	})
 }
 
 func IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx context.Context, data client.IntegrationResourceRoleResultSchema) (RoleResourceModel, diag.Diagnostics) {
@@ -704,6 +817,12 @@ func IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx context.Context,
 		return RoleResourceModel{}, diags
 	}
 
+	allowedDurationLabels, advDiags := utils.GetLabelSetFromAllowedDurations(data.AllowedDurations)
+	if advDiags.HasError() {
+		diags.Append(advDiags...)
+		return RoleResourceModel{}, diags
+	}
+
 	var virtualizedRole *utils.IdNameModel
 	if data.VirtualizedRole != nil {
 		virtualizedRole = &utils.IdNameModel{
@@ -726,6 +845,7 @@ func IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx context.Context,
 			Name: utils.TrimmedStringValue(data.Resource.Name),
 		},
 		AllowedDurations:        allowedDurationsValues,
+		AllowedDurationLabels:   allowedDurationLabels,
 		Workflow:                workflow,
 		PrerequisitePermissions: prerequisitePermissions,
 		VirtualizedRole:         virtualizedRole,
@@ -6,7 +6,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 
 	"github.com/entitleio/terraform-provider-entitle/docs"
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
@@ -15,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,6 +33,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RoleSyncedResource{}
 var _ resource.ResourceWithImportState = &RoleSyncedResource{}
+var _ resource.ResourceWithIdentity = &RoleSyncedResource{}
 
 // NewRoleSyncedResource creates a new instance of the RoleSyncedResource.
 func NewRoleSyncedResource() resource.Resource {
@@ -44,6 +45,13 @@ type RoleSyncedResource struct {
 	client *client.ClientWithResponses
 }
 
+// roleSyncedModel is the state of an entitle_role_synced, the state of an
+// entitle_role and whether to restore its settings on destroy.
+type roleSyncedModel struct {
+	RoleResourceModel
+	RestoreOnDestroy types.Bool `tfsdk:"restore_on_destroy"`
+}
+
 // Metadata sets the metadata for the resource.
 func (r *RoleSyncedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role_synced"
@@ -120,15 +128,19 @@ func (r *RoleSyncedResource) Schema(ctx context.Context, req resource.SchemaRequ
 				ElementType:         types.NumberType,
 				Optional:            true,
 				Computed:            true,
-				Description:         "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
-				MarkdownDescription: "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited",
+				Description:         "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nConflicts with allowed_duration_labels.",
+				MarkdownDescription: "As the admin, you can set different durations for the role, compared to the workflow linked to it. \nAllowed values:\n  - 1800 - 30min\n  - 3600 - 1 hour\n  - 10800 - 3 hours\n  - 21600 - 6 hours\n  - 43200 - 12 hours\n  - 57600 - 16 hours\n  - 86400 - 24 hours\n  - 259200 - 3 days\n  - 604800 - 7 days\n  - 2628000  - ~30,4 days\n  - 7884000 - 91,25 days\n  - 15768000 - 182,5 days\n  - 31536000 - 365 days\n  - 63072000 - 730 days\n  - -1 - unlimited\nConflicts with `allowed_duration_labels`.",
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("allowed_duration_labels")),
+					validators.AllowedDurations{},
 				},
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
+					utils.AllowedDurationsFromLabels(),
 				},
 			},
+			"allowed_duration_labels": utils.AllowedDurationLabelsAttribute(),
 			"workflow": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					// Attribute: workflow id
@@ -275,6 +287,7 @@ func (r *RoleSyncedResource) Schema(ctx context.Context, req resource.SchemaRequ
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"restore_on_destroy": utils.RestoreOnDestroyAttribute("role"),
 		},
 	}
 }
@@ -322,7 +335,7 @@ func (r *RoleSyncedResource) Create(ctx context.Context, req resource.CreateRequ
 	name := createPlan.Name.ValueStringPointer()
 	externalID := createPlan.ExternalID.ValueStringPointer()
 	resourceID := createPlan.Resource.ID.ValueString()
-	roleID, err := r.getRoleID(ctx, uuid.MustParse(resourceID), externalID, name)
+	roleID, err := utils.FindRoleID(ctx, r.client, uuid.MustParse(resourceID), externalID, name)
 	if err != nil {
 		resp.Diagnostics.AddError("Role not found", fmt.Sprintf(
 			"Failed to get the Role by the name (%s) or external id (%s) and resource (%s), %s",
@@ -342,7 +355,7 @@ func (r *RoleSyncedResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -362,19 +375,28 @@ func (r *RoleSyncedResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	state, diags := IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
+	// Keep the settings from before the adoption, to restore on destroy.
+	resp.Diagnostics.Append(saveRoleRestoreSnapshot(ctx, resp.Private, apiResp.JSON200.Result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
 	// Save the data into Terraform state.
+	state := roleSyncedModel{RoleResourceModel: model, RestoreOnDestroy: createPlan.RestoreOnDestroy}
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+
 	r.compareAndUpdate(ctx, createPlan, apiResp.JSON200.Result, resp)
 	if resp.Diagnostics.HasError() {
 		return
@@ -387,10 +409,12 @@ type roleSyncedCreatePlan struct {
 	Resource                *utils.IdNameModel `tfsdk:"resource"`
 	ID                      types.String       `tfsdk:"id"`
 	AllowedDurations        types.Set          `tfsdk:"allowed_durations"`
+	AllowedDurationLabels   types.Set          `tfsdk:"allowed_duration_labels"`
 	Workflow                types.Object       `tfsdk:"workflow"`
 	PrerequisitePermissions types.List         `tfsdk:"prerequisite_permissions"`
 	VirtualizedRole         types.Object       `tfsdk:"virtualized_role"`
 	Requestable             types.Bool         `tfsdk:"requestable"`
+	RestoreOnDestroy        types.Bool         `tfsdk:"restore_on_destroy"`
 }
 
 func (r *RoleSyncedResource) compareAndUpdate(ctx context.Context, plan roleSyncedCreatePlan, result client.IntegrationResourceRoleResultSchema, resp *resource.CreateResponse) {
@@ -478,8 +502,12 @@ func (r *RoleSyncedResource) compareAndUpdate(ctx context.Context, plan roleSync
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, roleFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to update the role: %s", err.Error()),
@@ -487,13 +515,14 @@ func (r *RoleSyncedResource) compareAndUpdate(ctx context.Context, plan roleSync
 		return
 	}
 
-	data, diags := IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
+	model, diags := IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
 	// Save the updated data into Terraform state.
+	data := roleSyncedModel{RoleResourceModel: model, RestoreOnDestroy: plan.RestoreOnDestroy}
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -506,8 +535,8 @@ func (r *RoleSyncedResource) compareAndUpdate(ctx context.Context, plan roleSync
 // It retrieves the resource's data from the provider API requests,
 // maps it to the RoleSyncedResourceModel, and saves the data to Terraform state.
 func (r *RoleSyncedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Create an instance of the RoleSyncedResourceModel to store the resource data.
-	var data RoleResourceModel
+	// Create an instance of the roleSyncedModel to store the resource data.
+	var data roleSyncedModel
 
 	// Read Terraform prior state data into the model.
 	diags := req.State.Get(ctx, &data)
@@ -536,7 +565,7 @@ func (r *RoleSyncedResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -564,11 +593,23 @@ func (r *RoleSyncedResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	data, diags = IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
+	// The first read after an import takes the snapshot of the settings to
+	// restore on destroy.
+	pending, diags := utils.IsRestorePending(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if pending {
+		resp.Diagnostics.Append(saveRoleRestoreSnapshot(ctx, resp.Private, apiResp.JSON200.Result)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RoleResourceModel, diags = IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	data.RestoreOnDestroy = utils.RestoreOnDestroy(data.RestoreOnDestroy)
 
 	// Save the updated data into Terraform state.
 	diags = resp.State.Set(ctx, &data)
@@ -576,6 +617,8 @@ func (r *RoleSyncedResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update handles updates to an existing resource of type Entitle Role.
@@ -583,8 +626,8 @@ func (r *RoleSyncedResource) Read(ctx context.Context, req resource.ReadRequest,
 // It reads the updated Terraform plan data, sends a request to the Entitle API
 // to update the resource, and saves the updated resource data into Terraform state.
 func (r *RoleSyncedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Create an instance of the RoleSyncedResourceModel to store the resource data.
-	var data RoleResourceModel
+	// Create an instance of the roleSyncedModel to store the resource data.
+	var data roleSyncedModel
 
 	// Read Terraform plan data into the model.
 	diags := req.Plan.Get(ctx, &data)
@@ -671,7 +714,7 @@ func (r *RoleSyncedResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	err = utils.HTTPResponseToError(apiResp.StatusCode(), apiResp.Body)
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -680,6 +723,10 @@ func (r *RoleSyncedResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, roleFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -691,7 +738,7 @@ func (r *RoleSyncedResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	data, diags = IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
+	data.RoleResourceModel, diags = IntegrationResourceRoleResultSchemaToRoleResourceModel(ctx, apiResp.JSON200.Result)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -703,73 +750,127 @@ func (r *RoleSyncedResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
-// Delete is responsible for deleting an existing resource of type Entitle Role.
+// Delete is responsible for removing an existing resource of type Entitle Role
+// from Terraform state.
 //
-// It reads the resource's data from Terraform state, extracts the unique identifier,
-// and sends a request to delete the resource using API requests. If the deletion
-// is successful, it removes the resource from Terraform state.
+// Synced roles are owned by the upstream integration, so no delete request is
+// sent. With restore_on_destroy, the settings the role had before Terraform
+// adopted it are sent back to the Entitle API.
 func (r *RoleSyncedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Create an instance of the RoleSyncedResourceModel to store the resource data.
-	var data RoleResourceModel
+	// Create an instance of the roleSyncedModel to store the resource data.
+	var data roleSyncedModel
 
 	// Read Terraform prior state data into the model.
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Check for errors in reading Terraform state data.
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || !data.RestoreOnDestroy.ValueBool() {
 		return
 	}
 
-	// No action needed
-}
+	var request client.RolesUpdateJSONRequestBody
+	found, diags := utils.LoadRestoreSnapshot(ctx, req.Private, &request)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Nothing to Restore",
+			fmt.Sprintf("No settings were recorded when the role (%s) was adopted, it is only removed from state.", data.ID.ValueString()),
+		)
+		return
+	}
 
-// ImportState is used to import an existing resource's state into Terraform.
-//
-// It extracts the resource's identifier from the import request and sets
-// it in Terraform state using resource.ImportStatePassthroughID.
-func (r *RoleSyncedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
+	uid, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to parse the role id (%s) to UUID, got error: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
 
-// getRoleID paginates through IntegrationResourceListItemResponseSchema to find a role by exact name or external id
-// within the given integrationId.
-func (r *RoleSyncedResource) getRoleID(ctx context.Context, resourceID uuid.UUID, externalID, name *string) (*uuid.UUID, error) {
-	fetch := func(ctx context.Context, page int) ([]client.IntegrationResourceRoleListItemResponseSchema, int, error) {
-		params := client.RolesIndexParams{
-			PerPage:    utils.IntPointer(100),
-			Page:       utils.IntPointer(page),
-			Search:     name,
-			ResourceId: &resourceID,
-			ExternalId: externalID,
-		}
+	apiResp, err := r.client.RolesUpdateWithResponse(ctx, uid, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to restore the role by the id (%s), got error: %s", uid.String(), err),
+		)
+		return
+	}
 
-		resp, err := r.client.RolesIndexWithResponse(ctx, &params)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list roles: %w", err)
-		}
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+	if err != nil && !errors.Is(err, utils.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to restore the role by the id (%s), %s", uid.String(), err.Error()),
+		)
+	}
+}
 
-		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
-			return nil, 0, fmt.Errorf("API returned status %d while listing roles (page %d)",
-				resp.HTTPResponse.StatusCode, page)
-		}
+// saveRoleRestoreSnapshot saves the requestable, workflow, allowed durations
+// and prerequisite permissions of a role into its private state, as the update
+// request that restores them.
+//
+// A role without a workflow or prerequisite permissions of its own is saved
+// with a null workflow and an empty list of prerequisite permissions, so the
+// restore clears the ones Terraform set.
+func saveRoleRestoreSnapshot(ctx context.Context, private utils.PrivateState, result client.IntegrationResourceRoleResultSchema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prerequisites := make([][]client.IntegrationResourceRolesUpdateBodySchema_PrerequisitePermissions_Item, 0)
+	if result.PrerequisitePermissions != nil {
+		for _, pp := range *result.PrerequisitePermissions {
+			v, err := pp.AsPrerequisiteRolePermissionResponseSchema()
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Failed to read the prerequisite permissions to restore, error: %v", err))
+				return diags
+			}
 
-		if resp.JSON200 == nil || resp.JSON200.Result == nil {
-			return nil, 0, fmt.Errorf("received invalid role response structure (page %d)", page)
+			item := client.IntegrationResourceRolesUpdateBodySchema_PrerequisitePermissions_Item{}
+			err = item.MergePrerequisitePermissionCreateBodySchema(client.PrerequisitePermissionCreateBodySchema{
+				Default: v.Default,
+				Role: map[string]interface{}{
+					"id": v.Role.Id.String(),
+				},
+			})
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Failed to merge prerequisite permission data, error: %v", err))
+				return diags
+			}
+			prerequisites = append(prerequisites, []client.IntegrationResourceRolesUpdateBodySchema_PrerequisitePermissions_Item{item})
 		}
-
-		items := resp.JSON200.Result
-		total := int(resp.JSON200.Pagination.TotalPages)
-		return items, total, nil
 	}
 
-	if externalID != nil && *externalID != "" {
-		return utils.FindIDByExternalID(ctx, *externalID, fetch)
+	request := client.RolesUpdateJSONRequestBody{
+		Requestable:             &result.Requestable,
+		AllowedDurations:        &result.AllowedDurations,
+		PrerequisitePermissions: &prerequisites,
 	}
-	if name != nil && *name != "" {
-		return utils.FindIDByName(ctx, *name, fetch)
+	if result.Workflow != nil {
+		request.Workflow = &client.IdParamsSchema{Id: result.Workflow.Id}
 	}
 
-	return nil, fmt.Errorf("name or externalId must be set")
+	diags.Append(utils.SaveRestoreSnapshot(ctx, private, request)...)
+
+	return diags
+}
+
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *RoleSyncedResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+// ImportState is used to import an existing resource's state into Terraform.
+//
+// It extracts the resource's identifier from the import request and sets
+// it in Terraform state using resource.ImportStatePassthroughID.
+func (r *RoleSyncedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	resp.Diagnostics.Append(utils.MarkRestorePending(ctx, resp.Private)...)
 }
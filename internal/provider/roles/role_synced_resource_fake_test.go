@@ -0,0 +1,111 @@
+package roles_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestRoleSyncedResource_fakeRestoreOnDestroy(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "GitHub",
+		"application": map[string]any{"name": "github"},
+	})
+	resourceID := srv.Add(fakeentitle.Resources, map[string]any{
+		"name":        "my-repo",
+		"integration": map[string]any{"id": integrationID},
+	})
+	workflowID := srv.Add(fakeentitle.Workflows, map[string]any{"name": "Synced Workflow"})
+	readID := srv.Add(fakeentitle.Roles, map[string]any{
+		"name":     "read",
+		"resource": map[string]any{"id": resourceID},
+	})
+	// The adopted role has no workflow or prerequisite permissions of its own.
+	adminID := srv.Add(fakeentitle.Roles, map[string]any{
+		"name":             "admin",
+		"resource":         map[string]any{"id": resourceID},
+		"requestable":      false,
+		"allowedDurations": []any{float64(3600)},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             checkRoleRestored(srv, adminID),
+		Steps: []resource.TestStep{
+			// Create and Read testing: the configured settings are applied.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_role_synced" "my_role" {
+	name               = "admin"
+	requestable        = true
+	restore_on_destroy = true
+
+	resource = {
+		id = "%s"
+	}
+
+	workflow = {
+		id = "%s"
+	}
+
+	allowed_durations = [3600, 10800]
+
+	prerequisite_permissions = [
+		{
+			default = true
+			role = {
+				id = "%s"
+			}
+		}
+	]
+}
+`, resourceID, workflowID, readID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_role_synced.my_role", "id", adminID),
+					resource.TestCheckResourceAttr("entitle_role_synced.my_role", "requestable", "true"),
+					resource.TestCheckResourceAttr("entitle_role_synced.my_role", "workflow.id", workflowID),
+					resource.TestCheckResourceAttr("entitle_role_synced.my_role", "prerequisite_permissions.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase, and restores
+			// the settings the role had before the adoption.
+		},
+	})
+}
+
+// checkRoleRestored checks that a role in the fake is back to the settings it
+// was seeded with: not requestable, no workflow, no prerequisite permissions
+// and a single allowed duration.
+func checkRoleRestored(srv *fakeentitle.Server, id string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		role, ok := srv.Get(fakeentitle.Roles, id)
+		if !ok {
+			return fmt.Errorf("role %s not found", id)
+		}
+
+		if role["requestable"] != false {
+			return fmt.Errorf("expected role %s requestable to be restored to false, got %v", id, role["requestable"])
+		}
+
+		if role["workflow"] != nil {
+			return fmt.Errorf("expected role %s workflow to be cleared, got %v", id, role["workflow"])
+		}
+
+		if prerequisites, _ := role["prerequisitePermissions"].([]any); len(prerequisites) != 0 {
+			return fmt.Errorf("expected role %s prerequisite permissions to be cleared, got %v", id, prerequisites)
+		}
+
+		if durations, _ := role["allowedDurations"].([]any); len(durations) != 1 || durations[0] != float64(3600) {
+			return fmt.Errorf("expected role %s allowed durations to be restored, got %v", id, role["allowedDurations"])
+		}
+
+		return nil
+	}
+}
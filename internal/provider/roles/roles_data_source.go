@@ -203,7 +203,7 @@ func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	if err := utils.HTTPResponseToError(apiResp.HTTPResponse.StatusCode, apiResp.Body); err != nil {
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf("Failed to list roles: %s", err))
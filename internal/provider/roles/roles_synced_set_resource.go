@@ -0,0 +1,583 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RolesSyncedSetResource{}
+var _ resource.ResourceWithModifyPlan = &RolesSyncedSetResource{}
+
+// NewRolesSyncedSetResource creates a new instance of the RolesSyncedSetResource.
+func NewRolesSyncedSetResource() resource.Resource {
+	return &RolesSyncedSetResource{}
+}
+
+// RolesSyncedSetResource defines the resource implementation. It applies
+// common settings to the synced roles of a resource or integration that match
+// a selector.
+type RolesSyncedSetResource struct {
+	client *client.ClientWithResponses
+}
+
+// RolesSyncedSetResourceModel describes the resource data model.
+type RolesSyncedSetResourceModel struct {
+	ID                      types.String                 `tfsdk:"id"`
+	ResourceID              types.String                 `tfsdk:"resource_id"`
+	IntegrationID           types.String                 `tfsdk:"integration_id"`
+	NameRegex               types.String                 `tfsdk:"name_regex"`
+	ExternalIDPrefix        types.String                 `tfsdk:"external_id_prefix"`
+	Names                   types.Set                    `tfsdk:"names"`
+	Requestable             types.Bool                   `tfsdk:"requestable"`
+	Workflow                *utils.IdentityOnlyModel     `tfsdk:"workflow"`
+	AllowedDurations        types.Set                    `tfsdk:"allowed_durations"`
+	PrerequisitePermissions []rolesSyncedSetPrerequisite `tfsdk:"prerequisite_permissions"`
+	Roles                   types.Map                    `tfsdk:"roles"`
+}
+
+// rolesSyncedSetPrerequisite is a prerequisite permission of the roles.
+type rolesSyncedSetPrerequisite struct {
+	Default types.Bool               `tfsdk:"default"`
+	Role    *utils.IdentityOnlyModel `tfsdk:"role"`
+}
+
+// Metadata sets the metadata for the resource.
+func (r *RolesSyncedSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles_synced_set"
+}
+
+// Schema sets the schema for the resource.
+func (r *RolesSyncedSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	selectors := []path.Expression{
+		path.MatchRoot("name_regex"),
+		path.MatchRoot("external_id_prefix"),
+		path.MatchRoot("names"),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.RolesSyncedSetResourceMarkdownDescription,
+		Description:         "Applies common Entitle settings to the connector-synced roles of a resource or integration that match a selector.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the set in UUID format, generated by the provider.",
+				MarkdownDescription: "Identifier of the set in UUID format, generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Select the roles of this resource. Conflicts with integration_id.",
+				MarkdownDescription: "Select the roles of this resource. Conflicts with `integration_id`.",
+				Validators: []validator.String{
+					validators.UUID{},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("resource_id"), path.MatchRoot("integration_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Select the roles of all the resources of this integration. Conflicts with resource_id.",
+				MarkdownDescription: "Select the roles of all the resources of this integration. Conflicts with `resource_id`.",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Select the roles whose name matches this regular expression (RE2 syntax).",
+				MarkdownDescription: "Select the roles whose name matches this regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)).",
+				Validators: []validator.String{
+					validators.Regex{},
+					stringvalidator.ExactlyOneOf(selectors...),
+				},
+			},
+			"external_id_prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Select the roles whose external ID starts with this prefix.",
+				MarkdownDescription: "Select the roles whose external ID starts with this prefix.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"names": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Select the roles with these names.",
+				MarkdownDescription: "Select the roles with these names.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"requestable": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the selected roles are requestable. Left unchanged when not set.",
+				MarkdownDescription: "Whether the selected roles are requestable. Left unchanged when not set.",
+			},
+			"workflow": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						Description:         "The workflow's id.",
+						MarkdownDescription: "The workflow's id.",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The approval workflow of the selected roles. Left unchanged when not set.",
+				MarkdownDescription: "The approval workflow of the selected roles. Left unchanged when not set.",
+			},
+			"allowed_durations": schema.SetAttribute{
+				ElementType:         types.NumberType,
+				Optional:            true,
+				Description:         "The allowed durations of the selected roles, in seconds. Left unchanged when not set.",
+				MarkdownDescription: "The allowed durations of the selected roles, in seconds. Left unchanged when not set.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					validators.AllowedDurations{},
+				},
+			},
+			"prerequisite_permissions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"default": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							Description:         "Whether the permission is granted by default (default: false).",
+							MarkdownDescription: "Whether the permission is granted by default (default: false).",
+						},
+						"role": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required:            true,
+									Description:         "The identifier of the role to be granted.",
+									MarkdownDescription: "The identifier of the role to be granted.",
+									Validators: []validator.String{
+										validators.UUID{},
+									},
+								},
+							},
+							Required:            true,
+							Description:         "The role to be granted.",
+							MarkdownDescription: "The role to be granted.",
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "Users granted any of the selected roles automatically receive these permissions. Left unchanged when not set.",
+				MarkdownDescription: "Users granted any of the selected roles automatically receive these permissions. Left unchanged when not set.",
+			},
+			"roles": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The selected roles, their names by their ids. Planned from the roles matching the selector, so the plan shows the roles joining and leaving the set.",
+				MarkdownDescription: "The selected roles, their names by their ids. Planned from the roles matching the selector, so the plan shows the roles joining and leaving the set.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the provided client.
+func (r *RolesSyncedSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans the roles from the ones currently matching the selector.
+func (r *RolesSyncedSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy, or before the provider is configured.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RolesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isSelectorKnown(plan) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("roles"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	matched, err := r.matchRoles(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to list the roles: %s", err))
+		return
+	}
+
+	roles, diags := roleNames(matched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("roles"), roles)...)
+}
+
+// Create applies the settings to the planned roles.
+func (r *RolesSyncedSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RolesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	roles, diags := r.apply(ctx, plan, types.MapNull(types.StringType), true)
+	resp.Diagnostics.Append(diags...)
+	if roles.IsNull() {
+		return
+	}
+
+	// The roles updated are stored even when others failed. Terraform taints
+	// a set whose create failed, so the next apply replaces it, which sends
+	// the settings to every role of the set again.
+	plan.Roles = roles
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the roles of the set. Roles that no longer exist or no longer
+// match, and roles whose requestable or workflow was changed outside of
+// Terraform, are removed, so the next plan applies the settings again.
+func (r *RolesSyncedSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RolesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matched, err := r.matchRoles(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to list the roles: %s", err))
+		return
+	}
+
+	applied := data.Roles.Elements()
+	matched = slices.DeleteFunc(matched, func(role client.IntegrationResourceRoleListItemResponseSchema) bool {
+		_, ok := applied[role.Id.String()]
+		return !ok || !hasSettings(data, role)
+	})
+
+	var diags diag.Diagnostics
+	data.Roles, diags = roleNames(matched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies the settings to the planned roles. When the settings are
+// unchanged, only the roles that joined the set are updated.
+func (r *RolesSyncedSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RolesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := r.apply(ctx, plan, state.Roles, !sameSettings(plan, state))
+	resp.Diagnostics.Append(diags...)
+	if roles.IsNull() {
+		return
+	}
+
+	// The roles updated are stored even when others failed, so the next
+	// apply updates the failed ones only.
+	plan.Roles = roles
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the set from Terraform state only. The roles are owned by
+// the upstream integration and keep their settings.
+func (r *RolesSyncedSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RolesSyncedSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+}
+
+// apply sends the settings of the plan to its roles, listing them when they
+// were unknown at plan time, and returns the roles of the set once applied.
+// The roles of prior are skipped unless settingsChanged. The roles are null
+// when none could be updated.
+func (r *RolesSyncedSetResource) apply(ctx context.Context, plan RolesSyncedSetResourceModel, prior types.Map, settingsChanged bool) (types.Map, diag.Diagnostics) {
+	request, diags := rolesSyncedSetRequest(ctx, plan)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	planned := plan.Roles
+	if planned.IsUnknown() {
+		matched, err := r.matchRoles(ctx, plan)
+		if err != nil {
+			diags.AddError(utils.ErrApiConnection.Error(), fmt.Sprintf("Unable to list the roles: %s", err))
+			return types.MapNull(types.StringType), diags
+		}
+
+		var d diag.Diagnostics
+		planned, d = roleNames(matched)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+	}
+
+	roles, d := utils.ApplySyncedSet(ctx, "role", planned, prior, settingsChanged, func(ctx context.Context, id uuid.UUID) error {
+		apiResp, err := r.client.RolesUpdateWithBodyWithResponse(ctx, id, "application/json", bytes.NewReader(request))
+		if err != nil {
+			return err
+		}
+
+		return utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+	})
+	diags.Append(d...)
+
+	return roles, diags
+}
+
+// rolesSyncedSetRequest builds the JSON body of the update of the settings of
+// the set. The settings that are not configured are sent as null when they
+// are part of a generated update body, which clears them, so the body has the
+// configured settings only.
+func rolesSyncedSetRequest(ctx context.Context, plan RolesSyncedSetResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := map[string]any{}
+
+	if !plan.Requestable.IsNull() {
+		request["requestable"] = plan.Requestable.ValueBool()
+	}
+
+	if plan.Workflow != nil {
+		workflowID, err := uuid.Parse(plan.Workflow.Id.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Failed to parse the workflow id to UUID: %v", err))
+			return nil, diags
+		}
+		request["workflow"] = client.IdParamsSchema{Id: workflowID}
+	}
+
+	if !plan.AllowedDurations.IsNull() {
+		allowedDurations, d := utils.GetEnumAllowedDurationsSliceFromNumberSet(ctx, plan.AllowedDurations)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		request["allowedDurations"] = allowedDurations
+	}
+
+	if plan.PrerequisitePermissions != nil {
+		permissions := make([][]client.IntegrationResourceRolesUpdateBodySchema_PrerequisitePermissions_Item, 0, len(plan.PrerequisitePermissions))
+		for _, pp := range plan.PrerequisitePermissions {
+			item := client.IntegrationResourceRolesUpdateBodySchema_PrerequisitePermissions_Item{}
+			err := item.MergePrerequisitePermissionCreateBodySchema(client.PrerequisitePermissionCreateBodySchema{
+				Default: pp.Default.ValueBool(),
+				Role: map[string]interface{}{
+					"id": pp.Role.Id.ValueString(),
+				},
+			})
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Failed to merge prerequisite permission data, error: %v", err))
+				return nil, diags
+			}
+			permissions = append(permissions, []client.IntegrationResourceRolesUpdateBodySchema_PrerequisitePermissions_Item{item})
+		}
+		request["prerequisitePermissions"] = permissions
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to encode the role settings, error: %v", err))
+		return nil, diags
+	}
+
+	return body, diags
+}
+
+// matchRoles lists the roles of the resource or integration of the set and
+// returns the ones its selector matches.
+func (r *RolesSyncedSetResource) matchRoles(ctx context.Context, data RolesSyncedSetResourceModel) ([]client.IntegrationResourceRoleListItemResponseSchema, error) {
+	params := client.RolesIndexParams{PerPage: utils.IntPointer(100)}
+	if !data.ResourceID.IsNull() {
+		resourceID, err := uuid.Parse(data.ResourceID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the resource id to UUID: %w", err)
+		}
+		params.ResourceId = &resourceID
+	} else {
+		integrationID, err := uuid.Parse(data.IntegrationID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the integration id to UUID: %w", err)
+		}
+		params.IntegrationId = &integrationID
+	}
+
+	match, err := roleSelector(data)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := utils.AllPages(ctx, func(ctx context.Context, page int) ([]client.IntegrationResourceRoleListItemResponseSchema, int, error) {
+		params := params
+		params.Page = utils.IntPointer(page)
+
+		resp, err := r.client.RolesIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list roles: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing roles (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid role response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []client.IntegrationResourceRoleListItemResponseSchema
+	for _, role := range roles {
+		if match(role) && utils.IsApplicationWithSyncedResources(role.Resource.Integration.Application.Name) {
+			matched = append(matched, role)
+		}
+	}
+
+	// Apply the settings in a stable order.
+	slices.SortFunc(matched, func(a, b client.IntegrationResourceRoleListItemResponseSchema) int {
+		return strings.Compare(a.Id.String(), b.Id.String())
+	})
+
+	return matched, nil
+}
+
+// roleSelector returns whether a role matches the selector of the set.
+func roleSelector(data RolesSyncedSetResourceModel) (func(client.IntegrationResourceRoleListItemResponseSchema) bool, error) {
+	switch {
+	case !data.NameRegex.IsNull():
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex: %w", err)
+		}
+
+		return func(role client.IntegrationResourceRoleListItemResponseSchema) bool {
+			return re.MatchString(role.Name)
+		}, nil
+	case !data.ExternalIDPrefix.IsNull():
+		prefix := data.ExternalIDPrefix.ValueString()
+
+		return func(role client.IntegrationResourceRoleListItemResponseSchema) bool {
+			return role.ExternalId != nil && strings.HasPrefix(*role.ExternalId, prefix)
+		}, nil
+	default:
+		names := map[string]bool{}
+		for _, name := range data.Names.Elements() {
+			if name, ok := name.(types.String); ok {
+				names[name.ValueString()] = true
+			}
+		}
+
+		return func(role client.IntegrationResourceRoleListItemResponseSchema) bool {
+			return names[role.Name]
+		}, nil
+	}
+}
+
+// hasSettings reports whether the requestable and workflow of a listed role
+// are still the ones of the set, when the listing includes them.
+func hasSettings(data RolesSyncedSetResourceModel, role client.IntegrationResourceRoleListItemResponseSchema) bool {
+	if !data.Requestable.IsNull() && role.Requestable != nil && *role.Requestable != data.Requestable.ValueBool() {
+		return false
+	}
+
+	if data.Workflow != nil && role.Workflow != nil && role.Workflow.Id.String() != data.Workflow.Id.ValueString() {
+		return false
+	}
+
+	return true
+}
+
+// isSelectorKnown reports whether the scope and selector of the set are known,
+// so its roles can be listed at plan time.
+func isSelectorKnown(data RolesSyncedSetResourceModel) bool {
+	return !data.ResourceID.IsUnknown() && !data.IntegrationID.IsUnknown() &&
+		!data.NameRegex.IsUnknown() && !data.ExternalIDPrefix.IsUnknown() && !data.Names.IsUnknown()
+}
+
+// sameSettings reports whether two models of the set have the same settings.
+func sameSettings(a, b RolesSyncedSetResourceModel) bool {
+	sameWorkflow := (a.Workflow == nil) == (b.Workflow == nil) &&
+		(a.Workflow == nil || a.Workflow.Id.Equal(b.Workflow.Id))
+
+	samePrerequisites := slices.EqualFunc(a.PrerequisitePermissions, b.PrerequisitePermissions, func(a, b rolesSyncedSetPrerequisite) bool {
+		return a.Default.Equal(b.Default) && a.Role.Id.Equal(b.Role.Id)
+	})
+
+	return sameWorkflow && samePrerequisites &&
+		(a.PrerequisitePermissions == nil) == (b.PrerequisitePermissions == nil) &&
+		a.Requestable.Equal(b.Requestable) &&
+		a.AllowedDurations.Equal(b.AllowedDurations)
+}
+
+// roleNames returns the names of roles by their ids.
+func roleNames(roles []client.IntegrationResourceRoleListItemResponseSchema) (types.Map, diag.Diagnostics) {
+	names := make(map[string]string, len(roles))
+	for _, role := range roles {
+		names[role.Id.String()] = role.Name
+	}
+
+	return utils.SyncedSetItems(names)
+}
@@ -0,0 +1,99 @@
+package roles_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestRolesSyncedSetResource_fake(t *testing.T) {
+	srv, providerConfig := testhelpers.NewFakeServer(t)
+
+	integrationID := srv.Add(fakeentitle.Integrations, map[string]any{
+		"name":        "GitHub",
+		"application": map[string]any{"name": "github"},
+	})
+	resourceID := srv.Add(fakeentitle.Resources, map[string]any{
+		"name":        "my-repo",
+		"integration": map[string]any{"id": integrationID},
+	})
+	workflowID := srv.Add(fakeentitle.Workflows, map[string]any{"name": "Synced Workflow"})
+	roleID := func(name string) string {
+		return srv.Add(fakeentitle.Roles, map[string]any{
+			"name":             name,
+			"resource":         map[string]any{"id": resourceID},
+			"requestable":      false,
+			"workflow":         map[string]any{"id": workflowID},
+			"allowedDurations": []any{float64(3600)},
+		})
+	}
+	adminID, readID := roleID("admin"), roleID("read")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing: the settings that are not configured
+			// keep their synced values.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_roles_synced_set" "my_roles" {
+	resource_id = "%s"
+	names       = ["admin"]
+	requestable = true
+}
+`, resourceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "roles.%", "1"),
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "roles."+adminID, "admin"),
+					checkRole(srv, adminID, true, workflowID),
+					checkRole(srv, readID, false, workflowID),
+				),
+			},
+			// Update testing: roles matching the new selector join the set.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "entitle_roles_synced_set" "my_roles" {
+	resource_id = "%s"
+	name_regex  = ".*"
+	requestable = true
+}
+`, resourceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "roles.%", "2"),
+					checkRole(srv, readID, true, workflowID),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// checkRole checks the requestable and workflow of a role in the fake, and
+// that its allowed durations were kept.
+func checkRole(srv *fakeentitle.Server, id string, requestable bool, workflowID string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		role, ok := srv.Get(fakeentitle.Roles, id)
+		if !ok {
+			return fmt.Errorf("role %s not found", id)
+		}
+
+		if role["requestable"] != requestable {
+			return fmt.Errorf("expected role %s requestable to be %t, got %v", id, requestable, role["requestable"])
+		}
+
+		if workflow, _ := role["workflow"].(map[string]any); workflow == nil || workflow["id"] != workflowID {
+			return fmt.Errorf("expected role %s workflow to be %s, got %v", id, workflowID, role["workflow"])
+		}
+
+		if durations, _ := role["allowedDurations"].([]any); len(durations) != 1 || durations[0] != float64(3600) {
+			return fmt.Errorf("expected role %s allowed durations to be kept, got %v", id, role["allowedDurations"])
+		}
+
+		return nil
+	}
+}
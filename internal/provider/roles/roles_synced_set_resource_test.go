@@ -0,0 +1,56 @@
+//go:build acceptance
+
+package roles_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestRolesSyncedSetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_roles_synced_set" "my_roles" {
+	resource_id = "%s"
+	names       = ["%s"]
+	requestable = false
+}
+`, os.Getenv("ENTITLE_RESOURCE_SYNCED_ID"), os.Getenv("ENTITLE_ROLE_SYNCED_NAME")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "resource_id", os.Getenv("ENTITLE_RESOURCE_SYNCED_ID")),
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "requestable", "false"),
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "roles.%", "1"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_roles_synced_set.my_roles", "id"),
+				),
+			},
+			// Update testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+resource "entitle_roles_synced_set" "my_roles" {
+	resource_id = "%s"
+	name_regex  = "^%s$"
+	requestable = true
+}
+`, os.Getenv("ENTITLE_RESOURCE_SYNCED_ID"), os.Getenv("ENTITLE_ROLE_SYNCED_NAME")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "requestable", "true"),
+					resource.TestCheckResourceAttr("entitle_roles_synced_set.my_roles", "roles.%", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
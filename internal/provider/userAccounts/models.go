@@ -0,0 +1,12 @@
+package userAccounts
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccountModel represents the application account side of a user account link.
+type AccountModel struct {
+	Id    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
+	Euid  types.String `tfsdk:"euid"`
+}
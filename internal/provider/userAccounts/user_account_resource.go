@@ -0,0 +1,425 @@
+// Package userAccounts provides the implementation of the Entitle User Account resource and data source for Terraform.
+// It links directory users to application accounts discovered by an integration.
+package userAccounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserAccountResource{}
+var _ resource.ResourceWithImportState = &UserAccountResource{}
+var _ resource.ResourceWithIdentity = &UserAccountResource{}
+
+// NewUserAccountResource creates a new instance of the UserAccountResource.
+func NewUserAccountResource() resource.Resource {
+	return &UserAccountResource{}
+}
+
+// UserAccountResource defines the resource implementation.
+type UserAccountResource struct {
+	client *client.ClientWithResponses
+}
+
+// UserAccountResourceModel describes the resource data model.
+type UserAccountResourceModel struct {
+	ID        types.String        `tfsdk:"id"`
+	User      *utils.IdEmailModel `tfsdk:"user"`
+	Account   *AccountModel       `tfsdk:"account"`
+	CreatedAt types.String        `tfsdk:"created_at"`
+}
+
+// Metadata is a function to set the TypeName for the Entitle User Account resource.
+func (r *UserAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_account"
+}
+
+// Schema is a function to define the schema for the Entitle User Account resource.
+func (r *UserAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.UserAccountResourceMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Entitle User Account link identifier in uuid format",
+				MarkdownDescription: "Entitle User Account link identifier in uuid format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.SingleNestedAttribute{
+				Required:            true,
+				Description:         "The directory user the account belongs to.",
+				MarkdownDescription: "The directory user the account belongs to.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						Description:         "the user's identifier in uuid format",
+						MarkdownDescription: "the user's identifier in uuid format",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"email": schema.StringAttribute{
+						Computed:            true,
+						Description:         "the user's email address",
+						MarkdownDescription: "the user's email address",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"account": schema.SingleNestedAttribute{
+				Required:            true,
+				Description:         "The application account that is mapped to the user.",
+				MarkdownDescription: "The application account that is mapped to the user.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:            true,
+						Description:         "the account's identifier in uuid format",
+						MarkdownDescription: "the account's identifier in uuid format",
+						Validators: []validator.String{
+							validators.UUID{},
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"email": schema.StringAttribute{
+						Computed:            true,
+						Description:         "the account's email address",
+						MarkdownDescription: "the account's email address",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"euid": schema.StringAttribute{
+						Computed:            true,
+						Description:         "the account's identifier in the integrated application",
+						MarkdownDescription: "the account's identifier in the integrated application",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The time the link was created.",
+				MarkdownDescription: "The time the link was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure is a function to set the client configuration for the UserAccountResource.
+func (r *UserAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cli
+}
+
+// Create links the configured user to the configured account.
+//
+// It reads the Terraform plan data provided in req.Plan and maps it to the UserAccountResourceModel.
+// Then, it sends a request to the Entitle API to create the link.
+// If the creation is successful, it saves the link's data into Terraform state.
+func (r *UserAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserAccountResourceModel
+
+	// Read Terraform plan data into the model.
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.User.Id.ValueString()
+	accountID := plan.Account.Id.ValueString()
+
+	apiResp, err := r.client.UsersAccountsCreateWithResponse(ctx, client.UsersAccountsCreateJSONRequestBody{
+		Account: client.CreateUserAccountAccountSchema{
+			Id: accountID,
+		},
+		User: client.CreateUserAccountUserSchema{
+			Id: userID,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to link the user (%s) to the account (%s), got error: %v", userID, accountID, err),
+		)
+		return
+	}
+
+	err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf(
+				"Failed to link the user (%s) to the account (%s), status code: %d, %s",
+				userID,
+				accountID,
+				apiResp.HTTPResponse.StatusCode,
+				err.Error(),
+			),
+		)
+		return
+	}
+
+	var result *client.UserAccountResultSchema
+	if apiResp.JSON200 != nil {
+		result = &apiResp.JSON200.Result
+	} else {
+		// The API may answer with 201 and an empty body, in which case the link
+		// has to be looked up by its user and account.
+		result, err = r.findUserAccount(ctx, userID, func(item client.UserAccountResultSchema) bool {
+			return item.Account.Id == accountID
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				utils.ErrApiResponse.Error(),
+				fmt.Sprintf("Failed to get the link of the user (%s) to the account (%s), %s", userID, accountID, err.Error()),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created an Entitle User Account resource")
+
+	userAccountResultToModel(result, &plan)
+
+	// Save data into Terraform state.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// Read is used to read an existing resource of type Entitle User Account.
+//
+// The Entitle API has no endpoint returning a single link, so the link is
+// looked up in the users accounts list, narrowed down to the user when known.
+func (r *UserAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserAccountResourceModel
+
+	// Read Terraform prior state data into the model.
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	var userID string
+	if data.User != nil {
+		userID = data.User.Id.ValueString()
+	}
+
+	result, err := r.findUserAccount(ctx, userID, func(item client.UserAccountResultSchema) bool {
+		return item.Id.String() == id
+	})
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			tflog.Debug(ctx, "Resource no longer exists, removing from state")
+
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to get the user account by the id (%s), %s", id, err.Error()),
+		)
+		return
+	}
+
+	userAccountResultToModel(result, &data)
+
+	// Save updated data into Terraform state.
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
+}
+
+// Update is not supported; every configurable attribute forces a new link.
+func (r *UserAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserAccountResourceModel
+
+	// Read Terraform plan data into the model.
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Client Error",
+		fmt.Sprintf("Update not available for the resource id (%s)", data.ID.ValueString()),
+	)
+}
+
+// Delete removes the link between the user and the account.
+//
+// It reads the resource's data from Terraform state, extracts the unique identifier,
+// and sends a request to delete the link using API requests.
+// If the deletion is successful, it removes the resource from Terraform state.
+func (r *UserAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserAccountResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parsedUUID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to parse uuid of the user account, id: (%s), got error: %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	httpResp, err := r.client.UsersAccountsDeleteWithResponse(ctx, parsedUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to delete user account, id: (%s), got error: %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf(
+				"Unable to delete User Account, id: (%s), status code: %v, %s",
+				data.ID.ValueString(),
+				httpResp.HTTPResponse.StatusCode,
+				err.Error(),
+			),
+		)
+		return
+	}
+}
+
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *UserAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+// ImportState imports an existing user account link by its identifier.
+func (r *UserAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// findUserAccount pages through the users accounts list, optionally filtered
+// by user, and returns the first link accepted by match.
+func (r *UserAccountResource) findUserAccount(
+	ctx context.Context,
+	userID string,
+	match func(item client.UserAccountResultSchema) bool,
+) (*client.UserAccountResultSchema, error) {
+	fetch := func(ctx context.Context, page int) ([]client.UserAccountResultSchema, int, error) {
+		params := client.UsersAccountsIndexParams{
+			PerPage: utils.Float32Pointer(100),
+			Page:    utils.Float32Pointer(float32(page)),
+		}
+		if userID != "" {
+			params.UserId = &userID
+		}
+
+		apiResp, err := r.client.UsersAccountsIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to list user accounts: %w", err)
+		}
+
+		err = utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if apiResp.JSON200 == nil {
+			return nil, 0, fmt.Errorf("received invalid user accounts response structure (page %d)", page)
+		}
+
+		return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+	}
+
+	for item, err := range utils.Pages(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+
+		if match(item) {
+			return &item, nil
+		}
+	}
+
+	return nil, utils.ErrNotFound
+}
+
+// userAccountResultToModel copies the API representation of a link into the resource model.
+func userAccountResultToModel(result *client.UserAccountResultSchema, data *UserAccountResourceModel) {
+	data.ID = utils.TrimmedStringValue(result.Id.String())
+	data.CreatedAt = types.StringValue(result.CreatedAt.UTC().Format(time.RFC3339))
+	data.User = &utils.IdEmailModel{
+		Id:    utils.TrimmedStringValue(result.User.Id),
+		Email: utils.TrimmedStringValue(result.User.Email),
+	}
+	data.Account = &AccountModel{
+		Id:    utils.TrimmedStringValue(result.Account.Id),
+		Email: utils.TrimmedStringValue(result.Account.Email),
+		Euid:  utils.TrimmedStringValue(result.Account.Euid),
+	}
+}
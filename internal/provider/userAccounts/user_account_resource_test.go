@@ -0,0 +1,58 @@
+//go:build acceptance
+
+package userAccounts_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestUserAccountResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+data "entitle_accounts" "my_accounts" {
+	integration_id = "%s"
+}
+
+resource "entitle_user_account" "my_user_account" {
+	user = {
+		id = "%s"
+	}
+	account = {
+		id = data.entitle_accounts.my_accounts.accounts[0].id
+	}
+}
+`, os.Getenv("ENTITLE_INTEGRATION_ID"), os.Getenv("ENTITLE_USER1_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("entitle_user_account.my_user_account", "user.id", os.Getenv("ENTITLE_USER1_ID")),
+					resource.TestCheckResourceAttrPair(
+						"entitle_user_account.my_user_account", "account.id",
+						"data.entitle_accounts.my_accounts", "accounts.0.id",
+					),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("entitle_user_account.my_user_account", "id"),
+					resource.TestCheckResourceAttrSet("entitle_user_account.my_user_account", "user.email"),
+					resource.TestCheckResourceAttrSet("entitle_user_account.my_user_account", "account.euid"),
+					resource.TestCheckResourceAttrSet("entitle_user_account.my_user_account", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "entitle_user_account.my_user_account",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package userAccounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// Ensure the data source satisfies the framework interface.
+var _ datasource.DataSource = &UserAccountsDataSource{}
+
+// UserAccountsDataSource defines the list data source implementation.
+type UserAccountsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// NewUserAccountsDataSource creates a new instance of UserAccountsDataSource.
+func NewUserAccountsDataSource() datasource.DataSource {
+	return &UserAccountsDataSource{}
+}
+
+// UserAccountsDataSourceModel describes the data source model.
+type UserAccountsDataSourceModel struct {
+	UserID       types.String           `tfsdk:"user_id"`
+	AccountID    types.String           `tfsdk:"account_id"`
+	Filter       *utils.PaginationModel `tfsdk:"filter"`
+	UserAccounts []UserAccountListItem  `tfsdk:"user_accounts"`
+}
+
+// UserAccountListItem represents a single user account link in the list.
+type UserAccountListItem struct {
+	ID        types.String       `tfsdk:"id"`
+	User      utils.IdEmailModel `tfsdk:"user"`
+	Account   AccountModel       `tfsdk:"account"`
+	CreatedAt types.String       `tfsdk:"created_at"`
+}
+
+// Metadata sets the metadata for the data source.
+func (d *UserAccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_accounts"
+}
+
+// Schema defines the schema for the data source.
+func (d *UserAccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docs.UserAccountsDataSourceMarkdownDescription,
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Optional pagination for user accounts.",
+				Attributes: map[string]schema.Attribute{
+					"page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Page number of results to return (starting from 1). Used together with `per_page` for pagination.",
+					},
+					"per_page": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of results to return per page. Defaults to the API's configured page size if not specified.",
+					},
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter links belonging to a specific user ID (UUID).",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter links belonging to a specific account ID (UUID).",
+				Validators: []validator.String{
+					validators.UUID{},
+				},
+			},
+			"user_accounts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of user account links matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Computed: true},
+						"user": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"id":    schema.StringAttribute{Computed: true},
+								"email": schema.StringAttribute{Computed: true},
+							},
+						},
+						"account": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"id":    schema.StringAttribute{Computed: true},
+								"email": schema.StringAttribute{Computed: true},
+								"euid":  schema.StringAttribute{Computed: true},
+							},
+						},
+						"created_at": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the client used by the data source.
+func (d *UserAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+// Read retrieves user account links from the Entitle API using filters.
+func (d *UserAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserAccountsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.UsersAccountsIndexParams{}
+
+	if v := data.UserID.ValueString(); v != "" {
+		params.UserId = &v
+	}
+
+	if v := data.AccountID.ValueString(); v != "" {
+		params.AccountId = &v
+	}
+
+	if data.Filter != nil {
+		page, perPage := data.Filter.GetValues()
+		params.Page, params.PerPage = utils.Float32PointerFromInt(page), utils.Float32PointerFromInt(perPage)
+	}
+
+	// Call API
+	apiResp, err := d.client.UsersAccountsIndexWithResponse(ctx, &params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiConnection.Error(),
+			fmt.Sprintf("Unable to list user accounts: %s", err))
+		return
+	}
+
+	if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+		resp.Diagnostics.AddError(
+			utils.ErrApiResponse.Error(),
+			fmt.Sprintf("Failed to list user accounts: %s", err))
+		return
+	}
+
+	// Map API results
+	userAccounts := make([]UserAccountListItem, len(apiResp.JSON200.Result))
+	for i, ua := range apiResp.JSON200.Result {
+		userAccounts[i] = UserAccountListItem{
+			ID: types.StringValue(ua.Id.String()),
+			User: utils.IdEmailModel{
+				Id:    utils.TrimmedStringValue(ua.User.Id),
+				Email: utils.TrimmedStringValue(ua.User.Email),
+			},
+			Account: AccountModel{
+				Id:    utils.TrimmedStringValue(ua.Account.Id),
+				Email: utils.TrimmedStringValue(ua.Account.Email),
+				Euid:  utils.TrimmedStringValue(ua.Account.Euid),
+			},
+			CreatedAt: types.StringValue(ua.CreatedAt.UTC().Format(time.RFC3339)),
+		}
+	}
+
+	data.UserAccounts = userAccounts
+	tflog.Trace(ctx, "Read entitle user accounts list data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,54 @@
+//go:build acceptance
+
+package userAccounts_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/testhelpers"
+)
+
+func TestUserAccountsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testhelpers.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testhelpers.ProviderConfig + fmt.Sprintf(`
+data "entitle_accounts" "my_accounts" {
+	integration_id = "%s"
+}
+
+resource "entitle_user_account" "my_user_account" {
+	user = {
+		id = "%s"
+	}
+	account = {
+		id = data.entitle_accounts.my_accounts.accounts[0].id
+	}
+}
+
+data "entitle_user_accounts" "my_list" {
+	account_id = entitle_user_account.my_user_account.account.id
+}
+`, os.Getenv("ENTITLE_INTEGRATION_ID"), os.Getenv("ENTITLE_USER1_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("data.entitle_user_accounts.my_list", "user_accounts.0.user.id", os.Getenv("ENTITLE_USER1_ID")),
+					resource.TestCheckResourceAttrPair(
+						"data.entitle_user_accounts.my_list", "user_accounts.0.id",
+						"entitle_user_account.my_user_account", "id",
+					),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("data.entitle_user_accounts.my_list", "user_accounts.0.account.euid"),
+					resource.TestCheckResourceAttrSet("data.entitle_user_accounts.my_list", "user_accounts.0.created_at"),
+				),
+			},
+		},
+	})
+}
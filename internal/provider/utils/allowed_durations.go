@@ -2,11 +2,21 @@ package utils
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
 )
 
 // AllowedDurationsEqual reports whether two EnumAllowedDurations slices contain the same values (order-independent).
@@ -49,3 +59,137 @@ func ConvertTerraformSetToAllowedDurations(ctx context.Context, terraformValue t
 
 	return allowedDurations, nil
 }
+
+// GetLabelSetFromAllowedDurations returns the labels of the durations, or a
+// null set when there are none. A duration that is not an EnumAllowedDurations
+// value is kept as its number of seconds.
+func GetLabelSetFromAllowedDurations(data []client.EnumAllowedDurations) (types.Set, diag.Diagnostics) {
+	if len(data) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	result := make([]attr.Value, 0, len(data))
+	for _, v := range data {
+		label, ok := v.Label()
+		if !ok {
+			label = strconv.FormatFloat(float64(v), 'f', -1, 32)
+		}
+		result = append(result, types.StringValue(label))
+	}
+
+	return types.SetValue(types.StringType, result)
+}
+
+// getAllowedDurationsFromLabelSet returns the durations of a set of labels.
+// Unknown labels are skipped, they are reported by validators.AllowedDurationLabels.
+func getAllowedDurationsFromLabelSet(data types.Set) []client.EnumAllowedDurations {
+	allowedDurations := make([]client.EnumAllowedDurations, 0, len(data.Elements()))
+	for _, item := range data.Elements() {
+		val, ok := item.(types.String)
+		if !ok {
+			continue
+		}
+
+		if d, ok := client.EnumAllowedDurationsFromLabel(val.ValueString()); ok {
+			allowedDurations = append(allowedDurations, d)
+		}
+	}
+
+	return allowedDurations
+}
+
+// AllowedDurationLabelsAttribute returns the schema of the
+// allowed_duration_labels attribute of a resource, the label form of its
+// allowed_durations attribute. The validators of allowed_durations decide
+// whether one of them must be set.
+func AllowedDurationLabelsAttribute() schema.SetAttribute {
+	const description = "The `allowed_durations` as labels, an alternative to setting them in seconds. Allowed values: " +
+		"`30m`, `1h`, `3h`, `6h`, `12h`, `16h`, `1d`, `3d`, `7d`, `1mo` (~30,4 days), `3mo` (91,25 days), " +
+		"`6mo` (182,5 days), `1y`, `2y` and `permanent`."
+
+	return schema.SetAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Description:         strings.ReplaceAll(description, "`", ""),
+		MarkdownDescription: description,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			validators.AllowedDurationLabels{},
+		},
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+			allowedDurationsModifier{labels: true},
+		},
+	}
+}
+
+// AllowedDurationsFromLabels returns a plan modifier for allowed_durations
+// that plans the durations of allowed_duration_labels when only the labels are
+// configured.
+func AllowedDurationsFromLabels() planmodifier.Set {
+	return allowedDurationsModifier{}
+}
+
+// allowedDurationsModifier plans one form of the allowed durations from the
+// other one when only the other one is configured, so both forms stay
+// consistent.
+type allowedDurationsModifier struct {
+	// labels is set for the modifier of allowed_duration_labels.
+	labels bool
+}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m allowedDurationsModifier) Description(ctx context.Context) string {
+	if m.labels {
+		return "Plans the labels of allowed_durations when only allowed_durations is configured."
+	}
+	return "Plans the durations of allowed_duration_labels when only allowed_duration_labels is configured."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m allowedDurationsModifier) MarkdownDescription(ctx context.Context) string {
+	if m.labels {
+		return "Plans the labels of `allowed_durations` when only `allowed_durations` is configured."
+	}
+	return "Plans the durations of `allowed_duration_labels` when only `allowed_duration_labels` is configured."
+}
+
+// PlanModifySet implements the plan modification logic.
+func (m allowedDurationsModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	other := path.Root("allowed_duration_labels")
+	if m.labels {
+		other = path.Root("allowed_durations")
+	}
+
+	var configured types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, other, &configured)...)
+	if resp.Diagnostics.HasError() || configured.IsNull() {
+		return
+	}
+
+	known := !configured.IsUnknown()
+	for _, v := range configured.Elements() {
+		known = known && !v.IsUnknown()
+	}
+	if !known {
+		resp.PlanValue = types.SetUnknown(req.PlanValue.ElementType(ctx))
+		return
+	}
+
+	var diags diag.Diagnostics
+	if m.labels {
+		var durations []client.EnumAllowedDurations
+		durations, diags = GetEnumAllowedDurationsSliceFromNumberSet(ctx, configured)
+		if !diags.HasError() {
+			resp.PlanValue, diags = GetLabelSetFromAllowedDurations(durations)
+		}
+	} else {
+		resp.PlanValue, diags = GetNumberSetFromAllowedDurations(getAllowedDurationsFromLabelSet(configured))
+	}
+	resp.Diagnostics.Append(diags...)
+}
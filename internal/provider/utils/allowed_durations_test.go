@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+func TestGetLabelSetFromAllowedDurations(t *testing.T) {
+	got, diags := GetLabelSetFromAllowedDurations([]client.EnumAllowedDurations{client.N1800, client.N604800, client.Minus1, 7200})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	want := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("30m"), types.StringValue("7d"), types.StringValue("permanent"), types.StringValue("7200"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if got, _ := GetLabelSetFromAllowedDurations(nil); !got.IsNull() {
+		t.Errorf("got %s, want a null set", got)
+	}
+}
+
+func TestAllowedDurationsModifier(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allowed_durations":       schema.SetAttribute{ElementType: types.NumberType, Optional: true, Computed: true},
+			"allowed_duration_labels": schema.SetAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+		},
+	}
+	numbers := tftypes.Set{ElementType: tftypes.Number}
+	strings := tftypes.Set{ElementType: tftypes.String}
+
+	config := func(durations, labels tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"allowed_durations":       durations,
+				"allowed_duration_labels": labels,
+			}),
+		}
+	}
+
+	// Only the labels are configured: the durations are planned from them.
+	cfg := config(
+		tftypes.NewValue(numbers, nil),
+		tftypes.NewValue(strings, []tftypes.Value{tftypes.NewValue(tftypes.String, "1h"), tftypes.NewValue(tftypes.String, "permanent")}),
+	)
+	req := planmodifier.SetRequest{
+		Path:        path.Root("allowed_durations"),
+		Config:      cfg,
+		ConfigValue: types.SetNull(types.NumberType),
+		PlanValue:   types.SetUnknown(types.NumberType),
+	}
+	resp := &planmodifier.SetResponse{PlanValue: req.PlanValue}
+	AllowedDurationsFromLabels().PlanModifySet(ctx, req, resp)
+
+	want := types.SetValueMust(types.NumberType, []attr.Value{
+		types.NumberValue(big.NewFloat(3600)), types.NumberValue(big.NewFloat(-1)),
+	})
+	if resp.Diagnostics.HasError() || !resp.PlanValue.Equal(want) {
+		t.Errorf("got %s (%v), want %s", resp.PlanValue, resp.Diagnostics, want)
+	}
+
+	// Only the durations are configured: the labels are planned from them.
+	cfg = config(
+		tftypes.NewValue(numbers, []tftypes.Value{tftypes.NewValue(tftypes.Number, 43200)}),
+		tftypes.NewValue(strings, nil),
+	)
+	req = planmodifier.SetRequest{
+		Path:        path.Root("allowed_duration_labels"),
+		Config:      cfg,
+		ConfigValue: types.SetNull(types.StringType),
+		PlanValue:   types.SetUnknown(types.StringType),
+	}
+	resp = &planmodifier.SetResponse{PlanValue: req.PlanValue}
+	allowedDurationsModifier{labels: true}.PlanModifySet(ctx, req, resp)
+
+	wantLabels := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("12h")})
+	if resp.Diagnostics.HasError() || !resp.PlanValue.Equal(wantLabels) {
+		t.Errorf("got %s (%v), want %s", resp.PlanValue, resp.Diagnostics, wantLabels)
+	}
+
+	// The other form is not known yet.
+	cfg = config(
+		tftypes.NewValue(numbers, tftypes.UnknownValue),
+		tftypes.NewValue(strings, nil),
+	)
+	req.Config = cfg
+	resp = &planmodifier.SetResponse{PlanValue: types.SetNull(types.StringType)}
+	allowedDurationsModifier{labels: true}.PlanModifySet(ctx, req, resp)
+	if !resp.PlanValue.IsUnknown() {
+		t.Errorf("got %s, want an unknown plan", resp.PlanValue)
+	}
+
+	// A configured value is left alone.
+	req.ConfigValue = wantLabels
+	resp = &planmodifier.SetResponse{PlanValue: wantLabels}
+	allowedDurationsModifier{labels: true}.PlanModifySet(ctx, req, resp)
+	if !resp.PlanValue.Equal(wantLabels) {
+		t.Errorf("got %s, want the configured %s", resp.PlanValue, wantLabels)
+	}
+}
@@ -104,6 +104,15 @@ func Float32Pointer(v float32) *float32 {
 	return new(v)
 }
 
+// Float32PointerFromInt takes a pointer to an int 'v' as input and returns a pointer to its float32 value.
+// If the pointer is nil, it returns nil.
+func Float32PointerFromInt(v *int) *float32 {
+	if v == nil {
+		return nil
+	}
+	return new(float32(*v))
+}
+
 // Float32Value takes a pointer to a float32 'v' as input and returns the float32 value it points to.
 // If the pointer is nil, it returns 0.0.
 func Float32Value(v *float32) float32 {
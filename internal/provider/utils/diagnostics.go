@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// APIFieldPaths maps the top-level fields of an API request body to the
+// attributes they are set from.
+type APIFieldPaths map[string]path.Path
+
+// fields returns the API field names of f, sorted for a stable diagnostic order.
+func (f APIFieldPaths) fields() []string {
+	fields := make([]string, 0, len(f))
+	for field := range f {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	return fields
+}
+
+// AddAttributeErrors adds an attribute error for each field-level detail of a
+// validation *EntitleAPIError, so users see which argument the API rejected.
+// It reports whether it did; it adds nothing and returns false when err is not
+// a validation error or names none of fields, in which case the caller should
+// add err as a plain error.
+func AddAttributeErrors(diags *diag.Diagnostics, err error, fields APIFieldPaths) bool {
+	var apiErr *EntitleAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	details := apiErr.FieldDetails(fields.fields()...)
+	for _, d := range details {
+		diags.AddAttributeError(
+			fields[d.Field],
+			ErrApiResponse.Error(),
+			fmt.Sprintf("The Entitle API rejected %s (status code: %d): %s", d.Field, apiErr.StatusCode, d.Message),
+		)
+	}
+
+	return len(details) > 0
+}
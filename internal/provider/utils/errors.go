@@ -4,21 +4,55 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
 )
 
 var (
 	ErrApiConnection       = errors.New("API Connection Error")
 	ErrApiResponse         = errors.New("API Response Error")
+	ErrAmbiguousMatch      = errors.New("more than one item matches")
 	ErrNotFound            = errors.New("results not found")
 	ErrOnlyManualOrVirtual = errors.New("only manual or virtual integration's entity can be deleted")
+	ErrValidation          = errors.New("the entitle API rejected the request")
 	errUnauthorizedToken   = errors.New("unauthorized token: update the entitle token and retry please")
 )
 
+// errorIDs maps the errorId of known API error bodies to the sentinel error an
+// EntitleAPIError with that ID wraps.
+var errorIDs = map[string]error{
+	"resource.notFound": ErrNotFound,
+}
+
+// ambiguousMatchError lists the IDs of the items matching value, so the user
+// can pick one and refer to it by ID instead.
+func ambiguousMatchError(kind, value string, ids []uuid.UUID) error {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	// Pages are fetched concurrently, so sort the IDs for a stable message.
+	slices.Sort(s)
+
+	return fmt.Errorf("%w: found %d items with %s %q (%s), use one of their IDs instead",
+		ErrAmbiguousMatch, len(ids), kind, value, strings.Join(s, ", "))
+}
+
 type ErrorBody struct {
 	ID      string `json:"errorId"`
 	Message string `json:"message"`
 }
 
+// ErrorDetail is a request body field rejected by a validation error, along
+// with the message the API rejected it with.
+type ErrorDetail struct {
+	Field   string
+	Message string
+}
+
 func GetErrorBody(data []byte) (ErrorBody, error) {
 	var result ErrorBody
 	err := json.Unmarshal(data, &result)
@@ -40,3 +74,86 @@ func (e ErrorBody) GetMessage() string {
 
 	return fmt.Sprintf(", getting error from entitle API error message: %s", e.Message)
 }
+
+// EntitleAPIError is a non-successful response from the Entitle API.
+// errors.Is matches it against the sentinel error registered for its errorId,
+// or the one inferred from its status code and message.
+type EntitleAPIError struct {
+	StatusCode int
+	ErrorID    string
+	Message    string
+	Method     string
+	Path       string
+
+	kind error
+}
+
+func (e *EntitleAPIError) Error() string {
+	var b strings.Builder
+
+	if e.kind != nil {
+		fmt.Fprintf(&b, "%s: ", e.kind)
+	}
+	if e.Method != "" {
+		fmt.Fprintf(&b, "%s %s ", e.Method, e.Path)
+	}
+	fmt.Fprintf(&b, "request failed, status code: %d", e.StatusCode)
+
+	if e.ErrorID != "" {
+		fmt.Fprintf(&b, ", error id: %s", e.ErrorID)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ", err: %s", e.Message)
+	}
+
+	return b.String()
+}
+
+func (e *EntitleAPIError) Unwrap() error {
+	return e.kind
+}
+
+// classify returns the sentinel error for an API error, preferring the
+// errorId registry over the legacy checks on the status code and message.
+func (e *EntitleAPIError) classify() error {
+	if err, ok := errorIDs[e.ErrorID]; ok {
+		return err
+	}
+
+	switch {
+	case e.StatusCode == 401, strings.Contains(e.Message, "is not a valid uuid"):
+		return errUnauthorizedToken
+	case strings.Contains(e.Message, "manual or virtual"):
+		return ErrOnlyManualOrVirtual
+	case e.StatusCode == 400, e.StatusCode == 422:
+		return ErrValidation
+	}
+
+	return nil
+}
+
+// FieldDetails returns the field-level details of a validation error: one for
+// each of fields that its message names. Error bodies carry no structured
+// field list, only an errorId and a message, so a field counts as named when
+// it appears in the message as a whole word.
+func (e *EntitleAPIError) FieldDetails(fields ...string) []ErrorDetail {
+	if !errors.Is(e.kind, ErrValidation) {
+		return nil
+	}
+
+	words := make(map[string]bool)
+	for _, w := range strings.FieldsFunc(e.Message, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}) {
+		words[w] = true
+	}
+
+	var details []ErrorDetail
+	for _, f := range fields {
+		if words[f] {
+			details = append(details, ErrorDetail{Field: f, Message: e.Message})
+		}
+	}
+
+	return details
+}
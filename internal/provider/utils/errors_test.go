@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestHTTPResponseToError_classification(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{"ok", http.StatusOK, "", nil},
+		{"not found by errorId", http.StatusNotFound, `{"errorId":"resource.notFound","message":"gone"}`, ErrNotFound},
+		{"bad request status", http.StatusBadRequest, `{"message":"bad"}`, ErrValidation},
+		{"unprocessable status", http.StatusUnprocessableEntity, `{"message":"bad"}`, ErrValidation},
+		{"unauthorized status", http.StatusUnauthorized, "", errUnauthorizedToken},
+		{"invalid uuid message", http.StatusBadRequest, `{"message":"x is not a valid uuid"}`, errUnauthorizedToken},
+		{"manual or virtual message", http.StatusBadRequest, `{"message":"only manual or virtual allowed"}`, ErrOnlyManualOrVirtual},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := HTTPResponseToError(tt.status, []byte(tt.body))
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPResponseToError_unknownErrorID(t *testing.T) {
+	err := HTTPResponseToError(http.StatusConflict, []byte(`{"errorId":"resource.conflict","message":"already exists"}`))
+
+	var apiErr *EntitleAPIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got %T, want *EntitleAPIError", err)
+	}
+	if apiErr.ErrorID != "resource.conflict" || apiErr.Message != "already exists" || apiErr.Unwrap() != nil {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+}
+
+func TestHTTPResponseToError_options(t *testing.T) {
+	notFound := []byte(`{"errorId":"resource.notFound","message":"gone"}`)
+	if err := HTTPResponseToError(http.StatusNotFound, notFound, WithIgnoreNotFound()); err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	pending := []byte(`{"message":"the request is pending"}`)
+	if err := HTTPResponseToError(http.StatusBadRequest, pending, WithIgnorePending()); err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+}
+
+func TestResponseToError_request(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Request:    &http.Request{Method: http.MethodPut, URL: &url.URL{Path: "/public/v1/bundles/1"}},
+	}
+
+	err := ResponseToError(resp, []byte(`{"message":"upstream"}`))
+	want := "PUT /public/v1/bundles/1 request failed, status code: 502, err: upstream"
+	if err == nil || err.Error() != want {
+		t.Fatalf("got %v, want %q", err, want)
+	}
+}
+
+func TestEntitleAPIError_FieldDetails(t *testing.T) {
+	body := []byte(`{"message":"allowedDurations must be one of the workflow durations"}`)
+
+	var apiErr *EntitleAPIError
+	if !errors.As(HTTPResponseToError(http.StatusBadRequest, body), &apiErr) {
+		t.Fatal("expected an *EntitleAPIError")
+	}
+
+	// Only whole words count, so "workflow" matches but "duration" does not.
+	got := apiErr.FieldDetails("allowedDurations", "workflow", "duration", "name")
+	want := []ErrorDetail{
+		{Field: "allowedDurations", Message: apiErr.Message},
+		{Field: "workflow", Message: apiErr.Message},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	// Only validation errors have field-level details.
+	if !errors.As(HTTPResponseToError(http.StatusBadGateway, body), &apiErr) {
+		t.Fatal("expected an *EntitleAPIError")
+	}
+	if got := apiErr.FieldDetails("allowedDurations"); got != nil {
+		t.Fatalf("got %+v, want no details", got)
+	}
+}
+
+func TestAddAttributeErrors(t *testing.T) {
+	fields := APIFieldPaths{
+		"name":  path.Root("name"),
+		"rules": path.Root("rules"),
+	}
+	body := []byte(`{"message":"name is too short, rules[0].underDuration is not allowed"}`)
+
+	var diags diag.Diagnostics
+	if !AddAttributeErrors(&diags, HTTPResponseToError(http.StatusBadRequest, body), fields) {
+		t.Fatal("expected attribute errors to be added")
+	}
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("got %d errors, want 2", diags.ErrorsCount())
+	}
+
+	for i, want := range []path.Path{path.Root("name"), path.Root("rules")} {
+		d, ok := diags[i].(diag.DiagnosticWithPath)
+		if !ok || !d.Path().Equal(want) {
+			t.Errorf("diagnostic %d: got %v, want path %s", i, diags[i], want)
+		}
+	}
+
+	// A message naming no attribute leaves the error to the caller.
+	unknown := []byte(`{"message":"the request is invalid"}`)
+	diags = nil
+	if AddAttributeErrors(&diags, HTTPResponseToError(http.StatusBadRequest, unknown), fields) || diags.HasError() {
+		t.Fatalf("expected no attribute errors, got %v", diags)
+	}
+}
@@ -13,33 +13,26 @@ type ExternalIDGetter interface {
 	GetID() uuid.UUID
 }
 
-// fetchEIDPageFn fetches a page of results and returns:
-//   - items: slice of T
-//   - totalPages: number of pages
-//   - error if any
-type fetchEIDPageFn[T ExternalIDGetter] func(ctx context.Context, page int) (items []T, totalPages int, err error)
-
-// FindIDByExternalID finds the ID of an item by external id.
-func FindIDByExternalID[T ExternalIDGetter](ctx context.Context, externalID string, fetch fetchEIDPageFn[T]) (*uuid.UUID, error) {
-	page := 1
-
-	for {
-		items, totalPages, err := fetch(ctx, page)
+// FindIDByExternalID finds the ID of an item by external id. It returns an
+// error wrapping ErrAmbiguousMatch when more than one item has that external id.
+func FindIDByExternalID[T ExternalIDGetter](ctx context.Context, externalID string, fetch fetchPageFn[T]) (*uuid.UUID, error) {
+	var ids []uuid.UUID
+	for item, err := range Pages(ctx, fetch) {
 		if err != nil {
 			return nil, err
 		}
 
-		for _, item := range items {
-			if item.GetExternalID() == externalID {
-				return new(item.GetID()), nil
-			}
+		if item.GetExternalID() == externalID {
+			ids = append(ids, item.GetID())
 		}
+	}
 
-		if page >= totalPages {
-			break
-		}
-		page++
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("item with external ID %q not found", externalID)
+	case 1:
+		return &ids[0], nil
 	}
 
-	return nil, fmt.Errorf("item with external ID %q not found", externalID)
+	return nil, ambiguousMatchError("external ID", externalID, ids)
 }
@@ -13,43 +13,26 @@ type NameableID interface {
 	GetID() uuid.UUID
 }
 
-// fetchPageFn fetches a page of results and returns:
-//   - items: slice of T
-//   - totalPages: number of pages
-//   - error if any
-type fetchPageFn[T NameableID] func(ctx context.Context, page int) (items []T, totalPages int, err error)
-
-// FindIDByName finds the ID of an item by name.
+// FindIDByName finds the ID of an item by name. It returns an error wrapping
+// ErrAmbiguousMatch when more than one item has that name.
 func FindIDByName[T NameableID](ctx context.Context, name string, fetch fetchPageFn[T]) (*uuid.UUID, error) {
-	page := 1
-
-	var id *uuid.UUID
-	for {
-		items, totalPages, err := fetch(ctx, page)
+	var ids []uuid.UUID
+	for item, err := range Pages(ctx, fetch) {
 		if err != nil {
 			return nil, err
 		}
 
-		for _, item := range items {
-			if item.GetName() == name {
-				if id != nil {
-					return nil, fmt.Errorf("found multiple IDs for %s", name)
-				}
-
-				itemID := item.GetID()
-				id = &itemID
-			}
-		}
-
-		if page >= totalPages {
-			break
+		if item.GetName() == name {
+			ids = append(ids, item.GetID())
 		}
-		page++
 	}
 
-	if id != nil {
-		return id, nil
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("item with name %q not found", name)
+	case 1:
+		return &ids[0], nil
 	}
 
-	return nil, fmt.Errorf("item with name %q not found", name)
+	return nil, ambiguousMatchError("name", name, ids)
 }
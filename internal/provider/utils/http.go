@@ -1,7 +1,7 @@
 package utils
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
 	"strings"
 )
@@ -24,7 +24,32 @@ func WithIgnorePending() HTTPErrorOption {
 	}
 }
 
+// HTTPResponseToError returns nil for a successful status code, and an
+// *EntitleAPIError built from the error body otherwise. Prefer ResponseToError
+// when the *http.Response is at hand, so the error names the failed request.
 func HTTPResponseToError(statusCode int, body []byte, opts ...HTTPErrorOption) error {
+	return responseToError(statusCode, "", "", body, opts...)
+}
+
+// ResponseToError is HTTPResponseToError for an *http.Response. The returned
+// *EntitleAPIError also carries the request method and path.
+func ResponseToError(resp *http.Response, body []byte, opts ...HTTPErrorOption) error {
+	if resp == nil {
+		return HTTPResponseToError(0, body, opts...)
+	}
+
+	var method, path string
+	if resp.Request != nil {
+		method = resp.Request.Method
+		if resp.Request.URL != nil {
+			path = resp.Request.URL.Path
+		}
+	}
+
+	return responseToError(resp.StatusCode, method, path, body, opts...)
+}
+
+func responseToError(statusCode int, method, path string, body []byte, opts ...HTTPErrorOption) error {
 	// Apply options
 	options := &httpErrorOptions{}
 	for _, opt := range opts {
@@ -34,30 +59,25 @@ func HTTPResponseToError(statusCode int, body []byte, opts ...HTTPErrorOption) e
 	switch statusCode {
 	case http.StatusOK, http.StatusCreated, http.StatusAccepted:
 		return nil
-	case http.StatusUnauthorized:
-		return errUnauthorizedToken
-	default:
-		errBody, _ := GetErrorBody(body)
-		if strings.Contains(errBody.GetMessage(), "is not a valid uuid") {
-			return errUnauthorizedToken
-		}
-
-		if errBody.ID == "resource.notFound" {
-			if options.ignoreNotFound {
-				return nil
-			}
-
-			return ErrNotFound
-		}
+	}
 
-		if strings.Contains(errBody.GetMessage(), "manual or virtual") {
-			return ErrOnlyManualOrVirtual
-		}
+	errBody, _ := GetErrorBody(body)
+	apiErr := &EntitleAPIError{
+		StatusCode: statusCode,
+		ErrorID:    errBody.ID,
+		Message:    errBody.Message,
+		Method:     method,
+		Path:       path,
+	}
+	apiErr.kind = apiErr.classify()
 
-		if options.ignorePending && strings.Contains(errBody.Message, "is pending") {
-			return nil
-		}
+	if options.ignoreNotFound && errors.Is(apiErr, ErrNotFound) {
+		return nil
+	}
 
-		return fmt.Errorf("request failed, status code: %d, err: %s", statusCode, errBody.GetMessage())
+	if options.ignorePending && strings.Contains(errBody.Message, "is pending") {
+		return nil
 	}
+
+	return apiErr
 }
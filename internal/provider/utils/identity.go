@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdentityPaths maps the string attributes of a resource identity to the
+// state attributes they are set from.
+type IdentityPaths map[string]path.Path
+
+// IDIdentityPaths are the identity paths of resources identified by their
+// UUID alone.
+var IDIdentityPaths = IdentityPaths{"id": path.Root("id")}
+
+// IDIdentitySchema returns the identity schema of resources identified by
+// their UUID alone.
+func IDIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Entitle ID (UUID) of the object.",
+			},
+		},
+	}
+}
+
+// attributeGetter is the state of a resource, such as a tfsdk.State or the
+// tfsdk.Resource of a list result.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// SetIdentity sets the identity of a resource from its state. It does nothing
+// when identity is nil, which is the case with Terraform versions that do not
+// support resource identities.
+func SetIdentity(ctx context.Context, state attributeGetter, identity *tfsdk.ResourceIdentity, paths IdentityPaths) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for attr, p := range paths {
+		var v types.String
+		diags.Append(state.GetAttribute(ctx, p, &v)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr), v)...)
+	}
+
+	return diags
+}
+
+// IdentityImportID returns the import ID an identity stands for: the given
+// identity attributes separated by "/", in the order of an attachment
+// resource's import ID format, such as "bundle_id", "role_id".
+func IdentityImportID(ctx context.Context, identity *tfsdk.ResourceIdentity, attrs ...string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parts := make([]string, len(attrs))
+	for i, attr := range attrs {
+		var v types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(attr), &v)...)
+		parts[i] = v.ValueString()
+	}
+
+	return strings.Join(parts, "/"), diags
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	stateSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"parent": schema.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Required: true}},
+			},
+		},
+	}
+	identitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":        identityschema.StringAttribute{OptionalForImport: true},
+			"parent_id": identityschema.StringAttribute{OptionalForImport: true},
+		},
+	}
+	paths := IdentityPaths{"id": path.Root("id"), "parent_id": path.Root("parent").AtName("id")}
+
+	parentType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	state := tfsdk.State{
+		Schema: stateSchema,
+		Raw: tftypes.NewValue(stateSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, "5a8b7c6d-1e2f-4a3b-9c8d-7e6f5a4b3c2d"),
+			"parent": tftypes.NewValue(parentType, nil),
+		}),
+	}
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := SetIdentity(ctx, state, identity, paths); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var id, parentID types.String
+	identity.GetAttribute(ctx, path.Root("id"), &id)
+	identity.GetAttribute(ctx, path.Root("parent_id"), &parentID)
+	if id.ValueString() != "5a8b7c6d-1e2f-4a3b-9c8d-7e6f5a4b3c2d" || !parentID.IsNull() {
+		t.Fatalf("got id %s, parent_id %s", id, parentID)
+	}
+
+	if diags := SetIdentity(ctx, state, nil, paths); diags.HasError() {
+		t.Fatalf("a nil identity should be ignored, got %v", diags)
+	}
+}
+
+func TestIdentityImportID(t *testing.T) {
+	ctx := context.Background()
+	identitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"parent_id": identityschema.StringAttribute{RequiredForImport: true},
+			"child_id":  identityschema.StringAttribute{RequiredForImport: true},
+		},
+	}
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw: tftypes.NewValue(identitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"parent_id": tftypes.NewValue(tftypes.String, "5a8b7c6d-1e2f-4a3b-9c8d-7e6f5a4b3c2d"),
+			"child_id":  tftypes.NewValue(tftypes.String, "0f1e2d3c-4b5a-4968-8776-655443322110"),
+		}),
+	}
+
+	importID, diags := IdentityImportID(ctx, identity, "parent_id", "child_id")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := "5a8b7c6d-1e2f-4a3b-9c8d-7e6f5a4b3c2d/0f1e2d3c-4b5a-4968-8776-655443322110"; importID != want {
+		t.Fatalf("got import ID %q, want %q", importID, want)
+	}
+}
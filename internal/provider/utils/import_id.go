@@ -0,0 +1,172 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	importNamePrefix     = "name:"
+	importExternalPrefix = "external:"
+	importNumberPrefix   = "number:"
+)
+
+// ImportResolvers resolve the import IDs a resource accepts besides its UUID.
+// A nil resolver means the resource does not accept that form.
+type ImportResolvers struct {
+	// Name resolves "name:<name>".
+	Name func(ctx context.Context, name string) (*uuid.UUID, error)
+
+	// ExternalID resolves "external:<parent_id>/<external_id>", where the
+	// parent is the object the external id is unique within.
+	ExternalID func(ctx context.Context, parentID uuid.UUID, externalID string) (*uuid.UUID, error)
+	// ExternalIDParent names the parent in error messages, e.g. "integration_id".
+	ExternalIDParent string
+
+	// Path resolves names separated by "/", such as
+	// "integration_name/resource_name". PathFormat is that format, and
+	// determines the number of names Path expects.
+	Path       func(ctx context.Context, names []string) (*uuid.UUID, error)
+	PathFormat string
+
+	// Number resolves "number:<number>".
+	Number func(ctx context.Context, number int) (*uuid.UUID, error)
+
+	// Identity resolves an import by a resource identity without an id, for
+	// resources whose identity has other attributes to look the object up by.
+	Identity func(ctx context.Context, identity *tfsdk.ResourceIdentity) (*uuid.UUID, error)
+}
+
+// formats returns the import ID formats r accepts.
+func (r ImportResolvers) formats() []string {
+	formats := []string{"<id>"}
+	if r.Name != nil {
+		formats = append(formats, importNamePrefix+"<name>")
+	}
+	if r.ExternalID != nil {
+		formats = append(formats, fmt.Sprintf("%s<%s>/<external_id>", importExternalPrefix, r.ExternalIDParent))
+	}
+	if r.Path != nil {
+		formats = append(formats, r.PathFormat)
+	}
+	if r.Number != nil {
+		formats = append(formats, importNumberPrefix+"<number>")
+	}
+
+	return formats
+}
+
+// ResolveImportID returns the UUID an import ID refers to. UUIDs are returned
+// as is; every other form is resolved through the matching resolver.
+func ResolveImportID(ctx context.Context, importID string, r ImportResolvers) (uuid.UUID, error) {
+	if id, err := uuid.Parse(importID); err == nil {
+		return id, nil
+	}
+
+	var (
+		id  *uuid.UUID
+		err error
+	)
+	switch {
+	case r.Name != nil && strings.HasPrefix(importID, importNamePrefix):
+		id, err = r.Name(ctx, strings.TrimPrefix(importID, importNamePrefix))
+	case r.ExternalID != nil && strings.HasPrefix(importID, importExternalPrefix):
+		parent, externalID, _ := strings.Cut(strings.TrimPrefix(importID, importExternalPrefix), "/")
+		parentID, parseErr := uuid.Parse(parent)
+		if parseErr != nil || externalID == "" {
+			return uuid.Nil, fmt.Errorf("import ID %q must have the format %s<%s>/<external_id>",
+				importID, importExternalPrefix, r.ExternalIDParent)
+		}
+		id, err = r.ExternalID(ctx, parentID, externalID)
+	case r.Number != nil && strings.HasPrefix(importID, importNumberPrefix):
+		number, atoiErr := strconv.Atoi(strings.TrimPrefix(importID, importNumberPrefix))
+		if atoiErr != nil {
+			return uuid.Nil, fmt.Errorf("import ID %q must have the format %s<number>", importID, importNumberPrefix)
+		}
+		id, err = r.Number(ctx, number)
+	case r.Path != nil && strings.Count(importID, "/") == strings.Count(r.PathFormat, "/"):
+		names := strings.Split(importID, "/")
+		for _, name := range names {
+			if name == "" {
+				return uuid.Nil, fmt.Errorf("import ID %q must have the format %s", importID, r.PathFormat)
+			}
+		}
+		id, err = r.Path(ctx, names)
+	default:
+		return uuid.Nil, fmt.Errorf("import ID %q must have one of the formats: %s",
+			importID, strings.Join(r.formats(), ", "))
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to resolve import ID %q: %w", importID, err)
+	}
+
+	return *id, nil
+}
+
+// ImportStateByID resolves req.ID with ResolveImportID, or the identity of an
+// import block with an identity, and sets the id attribute to the result.
+// Read then fills in the rest of the state.
+func ImportStateByID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, r ImportResolvers) {
+	if req.ID == "" && req.Identity != nil {
+		importStateByIdentity(ctx, req, resp, r)
+		return
+	}
+
+	id, err := ResolveImportID(ctx, req.ID, r)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+}
+
+func importStateByIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, r ImportResolvers) {
+	var id types.String
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if id.ValueString() != "" || r.Identity == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	resolved, err := r.Identity(ctx, req.Identity)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import Identity", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), resolved.String())...)
+}
+
+// SplitImportID parses the import ID of an attachment resource, the UUIDs of
+// the objects it links separated by "/", in the given format, such as
+// "<bundle_id>/<role_id>".
+func SplitImportID(importID, format string) ([]uuid.UUID, error) {
+	parts := strings.Split(importID, "/")
+	if len(parts) != strings.Count(format, "/")+1 {
+		return nil, fmt.Errorf("import ID %q must have the format %s", importID, format)
+	}
+
+	ids := make([]uuid.UUID, len(parts))
+	for i, part := range parts {
+		id, err := uuid.Parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("import ID %q must have the format %s, with UUIDs: %w", importID, format, err)
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
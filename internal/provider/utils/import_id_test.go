@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestResolveImportID(t *testing.T) {
+	resolved := uuid.MustParse("5a8b7c6d-1e2f-4a3b-9c8d-7e6f5a4b3c2d")
+	parent := uuid.MustParse("0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0")
+
+	var got []string
+	resolvers := ImportResolvers{
+		Name: func(_ context.Context, name string) (*uuid.UUID, error) {
+			got = append(got, "name="+name)
+			return &resolved, nil
+		},
+		ExternalID: func(_ context.Context, parentID uuid.UUID, externalID string) (*uuid.UUID, error) {
+			got = append(got, "parent="+parentID.String()+",external="+externalID)
+			return &resolved, nil
+		},
+		ExternalIDParent: "integration_id",
+		Path: func(_ context.Context, names []string) (*uuid.UUID, error) {
+			got = append(got, "path="+strings.Join(names, ","))
+			return &resolved, nil
+		},
+		PathFormat: "<integration_name>/<resource_name>",
+	}
+
+	tests := []struct {
+		importID string
+		want     string
+	}{
+		{"name:prod approvals", "name=prod approvals"},
+		{"external:" + parent.String() + "/arn:aws:s3:::bucket/key", "parent=" + parent.String() + ",external=arn:aws:s3:::bucket/key"},
+		{"AWS prod/billing", "path=AWS prod,billing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			got = nil
+			id, err := ResolveImportID(context.Background(), tt.importID, resolvers)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != resolved || len(got) != 1 || got[0] != tt.want {
+				t.Fatalf("got %s via %v, want %s via %s", id, got, resolved, tt.want)
+			}
+		})
+	}
+
+	id, err := ResolveImportID(context.Background(), resolved.String(), ImportResolvers{})
+	if err != nil || id != resolved {
+		t.Fatalf("got %s, %v, want the UUID passed through", id, err)
+	}
+}
+
+func TestResolveImportID_invalid(t *testing.T) {
+	resolvers := ImportResolvers{
+		Number: func(context.Context, int) (*uuid.UUID, error) {
+			return nil, errors.New("unreachable")
+		},
+	}
+
+	for _, importID := range []string{"name:policy", "number:3x", "a/b"} {
+		_, err := ResolveImportID(context.Background(), importID, resolvers)
+		if err == nil {
+			t.Fatalf("%s: expected an error", importID)
+		}
+	}
+
+	_, err := ResolveImportID(context.Background(), "name:policy", resolvers)
+	if !strings.Contains(err.Error(), "<id>, number:<number>") {
+		t.Fatalf("error should list the accepted formats, got %v", err)
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	parent, child := uuid.New(), uuid.New()
+
+	ids, err := SplitImportID(parent.String()+"/"+child.String(), "<bundle_id>/<role_id>")
+	if err != nil || len(ids) != 2 || ids[0] != parent || ids[1] != child {
+		t.Fatalf("got %v, %v", ids, err)
+	}
+
+	for _, importID := range []string{parent.String(), parent.String() + "/name", parent.String() + "/" + child.String() + "/x"} {
+		if _, err := SplitImportID(importID, "<bundle_id>/<role_id>"); err == nil || !strings.Contains(err.Error(), "<bundle_id>/<role_id>") {
+			t.Fatalf("%s: got %v, want an error with the format", importID, err)
+		}
+	}
+}
+
+type namedItem struct {
+	id   uuid.UUID
+	name string
+}
+
+func (i namedItem) GetID() uuid.UUID      { return i.id }
+func (i namedItem) GetName() string       { return i.name }
+func (i namedItem) GetExternalID() string { return i.name }
+
+func TestFindIDByName_ambiguous(t *testing.T) {
+	items := []namedItem{{uuid.New(), "dup"}, {uuid.New(), "unique"}, {uuid.New(), "dup"}}
+	fetch := func(_ context.Context, page int) ([]namedItem, int, error) {
+		// One item per page, to match across pages.
+		return items[page-1 : page], len(items), nil
+	}
+
+	if id, err := FindIDByName(context.Background(), "unique", fetch); err != nil || *id != items[1].id {
+		t.Fatalf("got %v, %v, want %s", id, err, items[1].id)
+	}
+
+	_, err := FindIDByName(context.Background(), "dup", fetch)
+	if !errors.Is(err, ErrAmbiguousMatch) || !strings.Contains(err.Error(), items[2].id.String()) {
+		t.Fatalf("got %v, want an ambiguous match listing both IDs", err)
+	}
+
+	_, err = FindIDByExternalID(context.Background(), "dup", fetch)
+	if !errors.Is(err, ErrAmbiguousMatch) {
+		t.Fatalf("got %v, want an ambiguous match", err)
+	}
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// allPagesPerPage is the page size used to walk every page when per_page is not set.
+const allPagesPerPage = 100
+
+// fetchListPageFn fetches a page of results and returns:
+//   - items: slice of T
+//   - totalPages: number of pages
+//   - error if any
+type fetchListPageFn[T any] func(ctx context.Context, search *string, page, perPage *int) (items []T, totalPages int, err error)
+
+// ListPages returns the items of the page selected by filter or, when allPages
+// is set, the items of every page in order, fetched concurrently.
+func ListPages[T any](ctx context.Context, filter *PaginationWithSearchModel, allPages bool, fetch fetchListPageFn[T]) ([]T, error) {
+	var search *string
+	var page, perPage *int
+	if filter != nil {
+		search, page, perPage = filter.GetValues()
+	}
+
+	if !allPages {
+		items, _, err := fetch(ctx, search, page, perPage)
+		return items, err
+	}
+
+	if perPage == nil {
+		perPage = new(allPagesPerPage)
+	}
+
+	return AllPages(ctx, func(ctx context.Context, page int) ([]T, int, error) {
+		return fetch(ctx, search, new(page), perPage)
+	})
+}
+
+// ListSearchedPages is ListPages for list endpoints without a search
+// parameter: fetch is called without search, and the items are matched
+// against it with MatchesSearch on their searched values. A search walks
+// every page of the endpoint, so that the selected page is a page of the
+// matching items rather than the matches found on a page of the API.
+func ListSearchedPages[T any](ctx context.Context, filter *PaginationWithSearchModel, allPages bool, fetch fetchListPageFn[T], searched func(item T) []string) ([]T, error) {
+	var search *string
+	var page, perPage *int
+	if filter != nil {
+		search, page, perPage = filter.GetValues()
+	}
+
+	if search == nil {
+		return ListPages(ctx, filter, allPages, fetch)
+	}
+
+	items, err := AllPages(ctx, func(ctx context.Context, page int) ([]T, int, error) {
+		return fetch(ctx, nil, new(page), new(allPagesPerPage))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items = slices.DeleteFunc(items, func(item T) bool {
+		return !MatchesSearch(search, searched(item)...)
+	})
+	if allPages {
+		return items, nil
+	}
+
+	start, size := 0, allPagesPerPage
+	if perPage != nil {
+		size = *perPage
+	}
+	if page != nil {
+		start = (*page - 1) * size
+	}
+
+	return items[min(start, len(items)):min(start+size, len(items))], nil
+}
+
+// MatchesSearch reports whether one of the values contains search, ignoring case.
+// It filters list endpoints that do not support a search parameter.
+func MatchesSearch(search *string, values ...string) bool {
+	if search == nil {
+		return true
+	}
+
+	s := strings.ToLower(*search)
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListSearchedPages(t *testing.T) {
+	// 3 pages of 100 numbers, of which the ones containing "7" are searched:
+	// the first page alone holds 19 of them.
+	fetch := func(ctx context.Context, search *string, page, perPage *int) ([]int, int, error) {
+		if search != nil {
+			t.Errorf("fetched with search %q", *search)
+		}
+
+		items := make([]int, *perPage)
+		for i := range items {
+			items[i] = (*page-1)**perPage + i
+		}
+		return items, 300 / *perPage, nil
+	}
+	searched := func(item int) []string { return []string{strconv.Itoa(item)} }
+
+	var want []int
+	for i := range 300 {
+		if MatchesSearch(new("7"), strconv.Itoa(i)) {
+			want = append(want, i)
+		}
+	}
+
+	// Without search, the page is fetched as is.
+	secondPage := make([]int, 50)
+	for i := range secondPage {
+		secondPage[i] = 50 + i
+	}
+
+	for name, tc := range map[string]struct {
+		filter   *PaginationWithSearchModel
+		allPages bool
+		want     []int
+	}{
+		"all pages":    {filter: &PaginationWithSearchModel{Search: types.StringValue("7")}, allPages: true, want: want},
+		"first page":   {filter: &PaginationWithSearchModel{Search: types.StringValue("7"), PerPage: types.Int64Value(25)}, want: want[:25]},
+		"second page":  {filter: &PaginationWithSearchModel{Search: types.StringValue("7"), Page: types.Int64Value(2), PerPage: types.Int64Value(25)}, want: want[25:50]},
+		"past the end": {filter: &PaginationWithSearchModel{Search: types.StringValue("7"), Page: types.Int64Value(9), PerPage: types.Int64Value(25)}, want: []int{}},
+		"no search":    {filter: &PaginationWithSearchModel{Page: types.Int64Value(2), PerPage: types.Int64Value(50)}, want: secondPage},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := ListSearchedPages(t.Context(), tc.filter, tc.allPages, fetch, searched)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// listResultFn sets the attributes of res known from a listed item, and
+// returns the item's display name.
+type listResultFn[T any] func(ctx context.Context, item T, res *tfsdk.Resource) (string, diag.Diagnostics)
+
+// StreamListResults sets the results of a list resource to the items of every
+// page fetch returns, up to req.Limit, as the pages arrive. The identity of
+// each result is set from the attributes result sets, through paths.
+func StreamListResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+	paths IdentityPaths,
+	fetch fetchPageFn[T],
+	result listResultFn[T],
+) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		var n int64
+		for item, err := range Pages(ctx, fetch) {
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError(ErrApiResponse.Error(), err.Error())
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			r := req.NewListResult(ctx)
+			r.DisplayName, r.Diagnostics = result(ctx, item, r.Resource)
+			r.Diagnostics.Append(SetIdentity(ctx, r.Resource, r.Identity, paths)...)
+
+			if !push(r) {
+				return
+			}
+
+			n++
+			if req.Limit > 0 && n >= req.Limit {
+				return
+			}
+		}
+	}
+}
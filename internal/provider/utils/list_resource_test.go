@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStreamListResults(t *testing.T) {
+	ctx := context.Background()
+	req := list.ListRequest{
+		ResourceSchema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":   schema.StringAttribute{Computed: true},
+				"name": schema.StringAttribute{Required: true},
+			},
+		},
+		ResourceIdentitySchema: IDIdentitySchema(),
+	}
+
+	pages := [][]string{{"a", "b"}, {"c"}}
+	fetch := func(_ context.Context, page int) ([]string, int, error) {
+		return pages[page-1], len(pages), nil
+	}
+	result := func(ctx context.Context, name string, res *tfsdk.Resource) (string, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		diags.Append(res.SetAttribute(ctx, path.Root("id"), "id-"+name)...)
+		diags.Append(res.SetAttribute(ctx, path.Root("name"), name)...)
+		return name, diags
+	}
+
+	collect := func(req list.ListRequest, fetch fetchPageFn[string]) []list.ListResult {
+		var stream list.ListResultsStream
+		StreamListResults(ctx, req, &stream, IDIdentityPaths, fetch, result)
+
+		var results []list.ListResult
+		for r := range stream.Results {
+			results = append(results, r)
+		}
+		return results
+	}
+
+	results := collect(req, fetch)
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, want := range []string{"a", "b", "c"} {
+		var id types.String
+		results[i].Identity.GetAttribute(ctx, path.Root("id"), &id)
+		if results[i].Diagnostics.HasError() || results[i].DisplayName != want || id.ValueString() != "id-"+want {
+			t.Errorf("result %d: got %q with identity %s, %v", i, results[i].DisplayName, id, results[i].Diagnostics)
+		}
+	}
+
+	req.Limit = 2
+	if results := collect(req, fetch); len(results) != 2 {
+		t.Fatalf("got %d results, want the limit of 2", len(results))
+	}
+
+	results = collect(req, func(context.Context, int) ([]string, int, error) {
+		return nil, 0, errors.New("boom")
+	})
+	if len(results) != 1 || !results[0].Diagnostics.HasError() {
+		t.Fatalf("got %v, want a single error result", results)
+	}
+}
@@ -0,0 +1,339 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+// lookupPerPage is the page size used when paging through a list endpoint to
+// find a single item.
+const lookupPerPage = 100
+
+// The lookups below mark their context with client.WithLookupCache, so the
+// pages they list are fetched once per provider process unless the
+// collection is written to.
+
+// FindWorkflowIDByName returns the ID of the workflow with the given name.
+func FindWorkflowIDByName(ctx context.Context, c *client.ClientWithResponses, name string) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.WorkflowIndexResultResponseSchema, int, error) {
+		params := client.WorkflowsIndexParams{
+			PerPage: Float32Pointer(lookupPerPage),
+			Page:    Float32Pointer(float32(page)),
+			Search:  StringPointer(name),
+		}
+
+		resp, err := c.WorkflowsIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list workflows: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing workflows (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid workflow response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	return FindIDByName(ctx, name, fetch)
+}
+
+// FindUserIDByEmail returns the ID of the user with the given email. Emails
+// are compared case-insensitively.
+func FindUserIDByEmail(ctx context.Context, c *client.ClientWithResponses, email string) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.UserResultSchema, int, error) {
+		params := client.UsersIndexParams{
+			PerPage: IntPointer(lookupPerPage),
+			Page:    IntPointer(page),
+			Search:  StringPointer(email),
+		}
+
+		resp, err := c.UsersIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing users (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid user response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	var ids []uuid.UUID
+	for u, err := range Pages(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(u.Email, email) {
+			ids = append(ids, u.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("%w: no user with email %q", ErrNotFound, email)
+	case 1:
+		return &ids[0], nil
+	}
+
+	return nil, ambiguousMatchError("email", email, ids)
+}
+
+// FindDirectoryGroupID returns the ID of the directory group with the given
+// name or, when byEmail is set, the given email. Emails are compared
+// case-insensitively.
+func FindDirectoryGroupID(ctx context.Context, c *client.ClientWithResponses, value string, byEmail bool) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.DirectoryGroupResponseSchema, int, error) {
+		params := client.DirectoryGroupsIndexParams{
+			PerPage: IntPointer(lookupPerPage),
+			Page:    IntPointer(page),
+			Search:  StringPointer(value),
+		}
+
+		resp, err := c.DirectoryGroupsIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list directory groups: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing directory groups (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid directory group response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	kind := "name"
+	if byEmail {
+		kind = "email"
+	}
+
+	var ids []uuid.UUID
+	for g, err := range Pages(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+
+		if byEmail && strings.EqualFold(g.Email, value) || !byEmail && g.Name == value {
+			ids = append(ids, g.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("%w: no directory group with %s %q", ErrNotFound, kind, value)
+	case 1:
+		return &ids[0], nil
+	}
+
+	return nil, ambiguousMatchError(kind, value, ids)
+}
+
+// FindBundleIDByName returns the ID of the bundle with the given name.
+func FindBundleIDByName(ctx context.Context, c *client.ClientWithResponses, name string) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.BundleIndexResultResponseSchema, int, error) {
+		params := client.BundlesIndexParams{
+			PerPage: Float32Pointer(lookupPerPage),
+			Page:    Float32Pointer(float32(page)),
+		}
+
+		resp, err := c.BundlesIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list bundles: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing bundles (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid bundle response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	return FindIDByName(ctx, name, fetch)
+}
+
+// FindIntegrationIDByName returns the ID of the integration with the given name.
+func FindIntegrationIDByName(ctx context.Context, c *client.ClientWithResponses, name string) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.IntegrationBaseResponseSchema, int, error) {
+		params := client.IntegrationsIndexParams{
+			PerPage: Float32Pointer(lookupPerPage),
+			Page:    Float32Pointer(float32(page)),
+		}
+
+		resp, err := c.IntegrationsIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list integrations: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing integration (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid integration response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	return FindIDByName(ctx, name, fetch)
+}
+
+// FindResourceID returns the ID of the resource of the given integration with
+// the given external id or, when externalID is empty, the given name.
+func FindResourceID(ctx context.Context, c *client.ClientWithResponses, integrationID uuid.UUID, externalID, name *string) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.IntegrationResourceListItemResponseSchema, int, error) {
+		params := client.ResourcesIndexParams{
+			PerPage:       IntPointer(lookupPerPage),
+			Page:          IntPointer(page),
+			Search:        name,
+			IntegrationId: integrationID.String(),
+			ExternalId:    externalID,
+		}
+
+		resp, err := c.ResourcesIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list resources: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing resources (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid resource response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	if externalID != nil && *externalID != "" {
+		return FindIDByExternalID(ctx, *externalID, fetch)
+	}
+	if name != nil && *name != "" {
+		return FindIDByName(ctx, *name, fetch)
+	}
+
+	return nil, fmt.Errorf("name or externalId must be set")
+}
+
+// FindRoleID returns the ID of the role of the given resource with the given
+// external id or, when externalID is empty, the given name.
+func FindRoleID(ctx context.Context, c *client.ClientWithResponses, resourceID uuid.UUID, externalID, name *string) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.IntegrationResourceRoleListItemResponseSchema, int, error) {
+		params := client.RolesIndexParams{
+			PerPage:    IntPointer(lookupPerPage),
+			Page:       IntPointer(page),
+			Search:     name,
+			ResourceId: &resourceID,
+			ExternalId: externalID,
+		}
+
+		resp, err := c.RolesIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list roles: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing roles (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid role response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	if externalID != nil && *externalID != "" {
+		return FindIDByExternalID(ctx, *externalID, fetch)
+	}
+	if name != nil && *name != "" {
+		return FindIDByName(ctx, *name, fetch)
+	}
+
+	return nil, fmt.Errorf("name or externalId must be set")
+}
+
+// FindPolicyIDByNumber returns the ID of the policy with the given number.
+func FindPolicyIDByNumber(ctx context.Context, c *client.ClientWithResponses, number int) (*uuid.UUID, error) {
+	ctx = client.WithLookupCache(ctx)
+
+	fetch := func(ctx context.Context, page int) ([]client.PolicyIndexResultResponseSchema, int, error) {
+		params := client.PoliciesIndexParams{
+			PerPage: Float32Pointer(lookupPerPage),
+			Page:    Float32Pointer(float32(page)),
+		}
+
+		resp, err := c.PoliciesIndexWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list policies: %w", err)
+		}
+
+		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
+			return nil, 0, fmt.Errorf("API returned status %d while listing policies (page %d)",
+				resp.HTTPResponse.StatusCode, page)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Result == nil {
+			return nil, 0, fmt.Errorf("received invalid policy response structure (page %d)", page)
+		}
+
+		return resp.JSON200.Result, int(resp.JSON200.Pagination.TotalPages), nil
+	}
+
+	for p, err := range Pages(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+
+		if int(p.Number) == number {
+			return &p.Id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("policy with number %d not found", number)
+}
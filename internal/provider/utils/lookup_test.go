@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+)
+
+func TestFind_fake(t *testing.T) {
+	ctx := context.Background()
+	srv := fakeentitle.NewServer()
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClientWithResponses(srv.URL,
+		client.WithHTTPClient(srv.Client()),
+		client.WithRequestEditorFn(client.SetBearerToken(fakeentitle.APIKey)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := srv.AddUser("owner@example.com")
+	wf, err := c.WorkflowsCreateWithResponse(ctx, client.WorkflowCreateBodySchema{Name: "wf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	integration, err := c.IntegrationsCreateWithResponse(ctx, client.IntegrationCreateBodySchema{
+		Name:        "manual",
+		Application: client.NameSchema{Name: "manual"},
+		Owner:       client.UserEntitySchema{Id: owner.ID},
+		Workflow:    client.IdParamsSchema{Id: wf.JSON200.Result.Id},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	integrationID := integration.JSON200.Result.Id
+
+	res, err := c.ResourcesCreateWithResponse(ctx, client.IntegrationResourcesCreateBodySchema{
+		Name:        "bucket",
+		Integration: client.IdParamsSchema{Id: integrationID},
+		Roles:       &[]client.IntegrationResourceRoleCreateSchema{{Name: "read"}, {Name: "write"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceID := res.JSON200.Result.Id
+
+	if id, err := FindWorkflowIDByName(ctx, c, "wf"); err != nil || *id != wf.JSON200.Result.Id {
+		t.Fatalf("workflow: got %v, %v", id, err)
+	}
+	if id, err := FindIntegrationIDByName(ctx, c, "manual"); err != nil || *id != integrationID {
+		t.Fatalf("integration: got %v, %v", id, err)
+	}
+	if id, err := FindResourceID(ctx, c, integrationID, nil, StringPointer("bucket")); err != nil || *id != resourceID {
+		t.Fatalf("resource by name: got %v, %v", id, err)
+	}
+	if _, err := FindRoleID(ctx, c, resourceID, nil, StringPointer("write")); err != nil {
+		t.Fatalf("role: %v", err)
+	}
+
+	if _, err := c.WorkflowsCreateWithResponse(ctx, client.WorkflowCreateBodySchema{Name: "wf"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FindWorkflowIDByName(ctx, c, "wf"); !errors.Is(err, ErrAmbiguousMatch) {
+		t.Fatalf("got %v, want an ambiguous match", err)
+	}
+}
+
+func TestFindPrincipals_fake(t *testing.T) {
+	ctx := context.Background()
+	srv := fakeentitle.NewServer()
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClientWithResponses(srv.URL,
+		client.WithHTTPClient(srv.Client()),
+		client.WithRequestEditorFn(client.SetBearerToken(fakeentitle.APIKey)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice := srv.AddUser("alice@example.com")
+	srv.AddUser("malice@example.com")
+	security := srv.AddGroup("Security", "security@example.com")
+	srv.AddGroup("Security Leads", "security-leads@example.com")
+
+	if id, err := FindUserIDByEmail(ctx, c, "Alice@Example.com"); err != nil || id.String() != alice.ID {
+		t.Fatalf("user: got %v, %v", id, err)
+	}
+	if _, err := FindUserIDByEmail(ctx, c, "bob@example.com"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want not found", err)
+	}
+	if id, err := FindDirectoryGroupID(ctx, c, "Security", false); err != nil || id.String() != security.ID {
+		t.Fatalf("group by name: got %v, %v", id, err)
+	}
+	if id, err := FindDirectoryGroupID(ctx, c, "security@example.com", true); err != nil || id.String() != security.ID {
+		t.Fatalf("group by email: got %v, %v", id, err)
+	}
+	if _, err := FindDirectoryGroupID(ctx, c, "security", false); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want not found", err)
+	}
+
+	srv.AddGroup("Security", "security-2@example.com")
+	if _, err := FindDirectoryGroupID(ctx, c, "Security", false); !errors.Is(err, ErrAmbiguousMatch) {
+		t.Fatalf("got %v, want an ambiguous match", err)
+	}
+}
@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
+)
+
+// maintainerAttempts bounds the read-modify-write attempts of a maintainer
+// attachment whose change keeps being lost to concurrent updates.
+const maintainerAttempts = 5
+
+// maintainerRetryDelay is the delay before the second attempt, doubled on
+// each attempt after it.
+var maintainerRetryDelay = 500 * time.Millisecond
+
+// ErrMaintainerConflict is returned when concurrent updates of the
+// maintainers keep dropping the change of a maintainer attachment.
+var ErrMaintainerConflict = errors.New("the maintainers kept being changed concurrently")
+
+// Maintainer is a user or group maintainer of an integration or resource.
+type Maintainer struct {
+	Type string
+	ID   string
+}
+
+// maintainerBody is a maintainer in an update request body.
+type maintainerBody struct {
+	Type  string                    `json:"type"`
+	User  *client.UserEntitySchema  `json:"user,omitempty"`
+	Group *client.GroupEntitySchema `json:"group,omitempty"`
+}
+
+// MaintainerAccess reads and writes the maintainers of an integration or
+// resource.
+type MaintainerAccess struct {
+	Get func(ctx context.Context) ([]Maintainer, error)
+	Set func(ctx context.Context, maintainers []Maintainer) error
+}
+
+// ParseMaintainers returns the maintainers of an integration or resource
+// response.
+func ParseMaintainers[T MaintainerInterface](items []T) ([]Maintainer, error) {
+	maintainers := make([]Maintainer, 0, len(items))
+	for _, item := range items {
+		data, err := item.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal maintainer data: %w", err)
+		}
+
+		var body MaintainerCommonResponseSchema
+		if err := json.Unmarshal(data, &body); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the maintainer data (%s): %w", data, err)
+		}
+
+		switch strings.ToLower(body.Type) {
+		case MaintainerTypeUser:
+			maintainers = append(maintainers, Maintainer{Type: MaintainerTypeUser, ID: body.User.Id.String()})
+		case MaintainerTypeGroup:
+			maintainers = append(maintainers, Maintainer{Type: MaintainerTypeGroup, ID: body.Group.Id.String()})
+		default:
+			return nil, fmt.Errorf("invalid maintainer type %q", body.Type)
+		}
+	}
+
+	return maintainers, nil
+}
+
+// MaintainersUpdateBody returns the JSON body of an integration or resource
+// update that sets the maintainers only, leaving the other settings as they
+// are.
+func MaintainersUpdateBody(maintainers []Maintainer) ([]byte, error) {
+	items := make([]maintainerBody, 0, len(maintainers))
+	for _, m := range maintainers {
+		switch m.Type {
+		case MaintainerTypeUser:
+			items = append(items, maintainerBody{Type: m.Type, User: &client.UserEntitySchema{Id: m.ID}})
+		case MaintainerTypeGroup:
+			items = append(items, maintainerBody{Type: m.Type, Group: &client.GroupEntitySchema{Id: m.ID}})
+		default:
+			return nil, fmt.Errorf("invalid maintainer type %q: only 'user' and 'group' are supported", m.Type)
+		}
+	}
+
+	return json.Marshal(map[string]any{"maintainers": items})
+}
+
+// HasMaintainer reports whether maintainers include m.
+func HasMaintainer(maintainers []Maintainer, m Maintainer) bool {
+	return slices.ContainsFunc(maintainers, func(other Maintainer) bool {
+		return other.Type == m.Type && strings.EqualFold(other.ID, m.ID)
+	})
+}
+
+// UpdateMaintainer adds m to the maintainers, or removes it when present is
+// false, with a read-modify-write that keeps the other maintainers. The
+// maintainers are read again after each write, and the write is retried when
+// a concurrent update of the maintainers dropped the change.
+func UpdateMaintainer(ctx context.Context, access MaintainerAccess, m Maintainer, present bool) error {
+	delay := maintainerRetryDelay
+	for attempt := 0; ; attempt++ {
+		current, err := access.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if HasMaintainer(current, m) == present {
+			return nil
+		}
+
+		if attempt == maintainerAttempts {
+			return fmt.Errorf("%w: gave up after %d attempts", ErrMaintainerConflict, attempt)
+		}
+
+		if attempt > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			delay *= 2
+		}
+
+		updated := slices.DeleteFunc(slices.Clone(current), func(other Maintainer) bool {
+			return other.Type == m.Type && strings.EqualFold(other.ID, m.ID)
+		})
+		if present {
+			updated = append(updated, m)
+		}
+
+		if err := access.Set(ctx, updated); err != nil {
+			return err
+		}
+	}
+}
+
+// WarnMaintainersRemoved warns when the authoritative "maintainers" attribute
+// of an integration or resource is planned to remove maintainers, such as the
+// ones added by the attachment resource named by attachment.
+func WarnMaintainersRemoved(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attachment string) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured, prior, planned types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("maintainers"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintainers"), &prior)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("maintainers"), &planned)...)
+	if resp.Diagnostics.HasError() || configured.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	kept := map[string]bool{}
+	for _, elem := range planned.Elements() {
+		id, _ := maintainerEntity(elem)
+		if id.IsUnknown() {
+			return
+		}
+		kept[strings.ToLower(id.ValueString())] = true
+	}
+
+	var removed []string
+	for _, elem := range prior.Elements() {
+		id, email := maintainerEntity(elem)
+		if id.IsNull() || id.IsUnknown() || kept[strings.ToLower(id.ValueString())] {
+			continue
+		}
+		removed = append(removed, maintainerName(id, email))
+	}
+	if len(removed) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("maintainers"),
+		"Maintainers Will Be Removed",
+		fmt.Sprintf("The maintainers attribute is authoritative, and this plan removes the maintainers it does not list: %s. "+
+			"If they were added by %s resources, the two keep undoing each other on every apply. "+
+			"Either list every maintainer here, or remove the maintainers attribute and use %s for all of them.",
+			strings.Join(removed, ", "), attachment, attachment),
+	)
+}
+
+// maintainerName returns the email of a maintainer, or its ID without one.
+func maintainerName(id, email types.String) string {
+	if !email.IsNull() && !email.IsUnknown() && email.ValueString() != "" {
+		return email.ValueString()
+	}
+
+	return id.ValueString()
+}
+
+// MaintainerAttachmentTypeAttribute returns the schema of the "type"
+// attribute of the maintainer attachment resources.
+func MaintainerAttachmentTypeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		Description:         "\"user\" or \"group\"",
+		MarkdownDescription: "\"user\" or \"group\"",
+		Validators: []validator.String{
+			stringvalidator.OneOf(MaintainerTypeUser, MaintainerTypeGroup),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// MaintainerAttachmentEntityAttribute returns the schema of the "entity"
+// attribute of the maintainer attachment resources.
+func MaintainerAttachmentEntityAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Maintainer's unique identifier.",
+				MarkdownDescription: "Maintainer's unique identifier.",
+				Validators: []validator.String{
+					validators.UUID{},
+					stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Maintainer's email (lowercase), resolved to the user's or group's id at plan time when id is not set.",
+				MarkdownDescription: "Maintainer's email (lowercase), resolved to the user's or group's `id` at plan time when `id` is not set.",
+				Validators: []validator.String{
+					validators.Email{},
+					validators.Lowercase{},
+				},
+			},
+		},
+		Required:            true,
+		Description:         "Maintainer's entity.",
+		MarkdownDescription: "Maintainer's entity.",
+	}
+}
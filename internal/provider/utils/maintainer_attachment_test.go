@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// fakeMaintainers is the maintainers of an object, along with a number of
+// writes to lose to a concurrent update that restores the previous ones.
+type fakeMaintainers struct {
+	maintainers []Maintainer
+	lose        int
+	writes      int
+}
+
+func (f *fakeMaintainers) access() MaintainerAccess {
+	return MaintainerAccess{
+		Get: func(context.Context) ([]Maintainer, error) {
+			return f.maintainers, nil
+		},
+		Set: func(_ context.Context, maintainers []Maintainer) error {
+			f.writes++
+			if f.lose > 0 {
+				f.lose--
+				return nil
+			}
+			f.maintainers = maintainers
+			return nil
+		},
+	}
+}
+
+func TestUpdateMaintainer(t *testing.T) {
+	maintainerRetryDelay = 0
+	ctx := context.Background()
+
+	alice := Maintainer{Type: MaintainerTypeUser, ID: "7d080bfa-9143-11ee-b9d1-0242ac120001"}
+	team := Maintainer{Type: MaintainerTypeGroup, ID: "7d080bfa-9143-11ee-b9d1-0242ac120002"}
+
+	f := &fakeMaintainers{maintainers: []Maintainer{team}, lose: 2}
+	if err := UpdateMaintainer(ctx, f.access(), alice, true); err != nil {
+		t.Fatal(err)
+	}
+	if !HasMaintainer(f.maintainers, alice) || !HasMaintainer(f.maintainers, team) || f.writes != 3 {
+		t.Fatalf("got %v after %d writes, want both maintainers after 3", f.maintainers, f.writes)
+	}
+
+	// Adding an existing maintainer does not write.
+	f.writes = 0
+	upper := Maintainer{Type: MaintainerTypeUser, ID: "7D080BFA-9143-11EE-B9D1-0242AC120001"}
+	if err := UpdateMaintainer(ctx, f.access(), upper, true); err != nil || f.writes != 0 {
+		t.Fatalf("got %v after %d writes", err, f.writes)
+	}
+
+	if err := UpdateMaintainer(ctx, f.access(), alice, false); err != nil {
+		t.Fatal(err)
+	}
+	if HasMaintainer(f.maintainers, alice) || !HasMaintainer(f.maintainers, team) {
+		t.Fatalf("got %v, want the group only", f.maintainers)
+	}
+
+	f = &fakeMaintainers{lose: maintainerAttempts + 1}
+	if err := UpdateMaintainer(ctx, f.access(), alice, true); !errors.Is(err, ErrMaintainerConflict) {
+		t.Fatalf("got %v, want %v", err, ErrMaintainerConflict)
+	}
+	if f.writes != maintainerAttempts {
+		t.Errorf("got %d writes, want %d", f.writes, maintainerAttempts)
+	}
+}
+
+func TestMaintainersUpdateBody(t *testing.T) {
+	body, err := MaintainersUpdateBody([]Maintainer{
+		{Type: MaintainerTypeUser, ID: "u"},
+		{Type: MaintainerTypeGroup, ID: "g"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"maintainers":[{"type":"user","user":{"id":"u"}},{"type":"group","group":{"id":"g"}}]}`
+	if string(body) != want || len(got) != 1 {
+		t.Errorf("got %s, want %s", body, want)
+	}
+
+	if _, err := MaintainersUpdateBody([]Maintainer{{Type: "team", ID: "t"}}); err == nil {
+		t.Error("got no error for an invalid maintainer type")
+	}
+}
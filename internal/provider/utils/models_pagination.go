@@ -57,3 +57,22 @@ func (m PaginationWithSearchAndExternalIdModel) GetValues() (search *string, pag
 
 	return
 }
+
+type PaginationModel struct {
+	Page    types.Int64 `tfsdk:"page"`
+	PerPage types.Int64 `tfsdk:"per_page"`
+}
+
+func (m PaginationModel) GetValues() (page, perPage *int) {
+	p := int(m.Page.ValueInt64())
+	if p > 0 {
+		page = new(p)
+	}
+
+	pp := int(m.PerPage.ValueInt64())
+	if pp > 0 {
+		perPage = new(pp)
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+// WorkflowEntityTypes maps the type of a workflow approval or notified entity
+// to the nested attribute holding the entity, or to "" for the types without
+// one.
+var WorkflowEntityTypes = map[string]string{
+	string(client.EnumApprovalEntityUserUserUser):                       "user",
+	string(client.DirectoryGroup):                                       "group",
+	string(client.OnCallIntegrationSchedule):                            "schedule",
+	"Webhook":                                                           "webhook",
+	string(client.SlackChannel):                                         "channel",
+	string(client.TeamsChannel):                                         "channel",
+	string(client.EnumApprovalEntityWithoutEntityAutomatic):             "",
+	string(client.EnumApprovalEntityWithoutEntityDirectManager):         "",
+	string(client.EnumApprovalEntityWithoutEntityIntegrationMaintainer): "",
+	string(client.EnumApprovalEntityWithoutEntityIntegrationOwner):      "",
+	string(client.EnumApprovalEntityWithoutEntityResourceMaintainer):    "",
+	string(client.EnumApprovalEntityWithoutEntityResourceOwner):         "",
+	string(client.EnumApprovalEntityWithoutEntityTeamMember):            "",
+}
+
+// WorkflowEntityTypeAliases maps the lowercase aliases accepted for the type
+// of a workflow approval or notified entity to the type they stand for.
+var WorkflowEntityTypeAliases = map[string]string{
+	"user":     string(client.EnumApprovalEntityUserUserUser),
+	"group":    string(client.DirectoryGroup),
+	"schedule": string(client.OnCallIntegrationSchedule),
+	"webhook":  "Webhook",
+}
+
+// WorkflowEntityTypeNames lists the workflow entity types, sorted, for error
+// messages.
+func WorkflowEntityTypeNames() string {
+	names := make([]string, 0, len(WorkflowEntityTypes))
+	for name := range WorkflowEntityTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return strings.Join(names, ", ")
+}
+
+// WorkflowEntityAttributeTypes returns the attribute types of a workflow
+// approval or notified entity object.
+func WorkflowEntityAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":     types.StringType,
+		"user":     types.ObjectType{AttrTypes: IdEmailModel{}.AttributeTypes()},
+		"group":    types.ObjectType{AttrTypes: IdNameModel{}.AttributeTypes()},
+		"schedule": types.ObjectType{AttrTypes: IdNameModel{}.AttributeTypes()},
+		"webhook":  types.ObjectType{AttrTypes: IdNameModel{}.AttributeTypes()},
+		"channel":  types.ObjectType{AttrTypes: IdentityOnlyModel{}.AttributeTypes()},
+	}
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of the Entitle objects locked by LockObject.
+const (
+	ObjectKindBundle      = "bundle"
+	ObjectKindIntegration = "integration"
+	ObjectKindPolicy      = "policy"
+	ObjectKindResource    = "resource"
+)
+
+// objectLocks holds a mutex per locked Entitle object, keyed by its kind and
+// ID. It is shared by every resource of the provider.
+var objectLocks sync.Map
+
+// LockObject locks the Entitle object of the given kind and ID until the
+// returned function is called. Resources that read-modify-write part of an
+// object hold it, so parallel applies of the resources attached to the same
+// object do not overwrite each other's changes.
+func LockObject(kind string, id uuid.UUID) (unlock func()) {
+	mu, _ := objectLocks.LoadOrStore(kind+"/"+id.String(), &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	return mu.(*sync.Mutex).Unlock
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestLockObject(t *testing.T) {
+	id := uuid.New()
+
+	var wg sync.WaitGroup
+	var held, maxHeld int
+	var counter sync.Mutex
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := LockObject(ObjectKindBundle, id)
+			defer unlock()
+
+			counter.Lock()
+			held++
+			maxHeld = max(maxHeld, held)
+			counter.Unlock()
+
+			counter.Lock()
+			held--
+			counter.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if maxHeld != 1 {
+		t.Fatalf("the lock was held by %d goroutines at once, want 1", maxHeld)
+	}
+
+	// Other objects, and objects of another kind with the same ID, have their
+	// own locks.
+	unlock := LockObject(ObjectKindBundle, id)
+	defer unlock()
+	LockObject(ObjectKindBundle, uuid.New())()
+	LockObject(ObjectKindPolicy, id)()
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// pageWorkers bounds the number of pages fetched at the same time. The client
+// rate limiter still applies to every request.
+const pageWorkers = 4
+
+// fetchPageFn fetches a page of results and returns:
+//   - items: slice of T
+//   - totalPages: number of pages
+//   - error if any
+//
+// It is called from several goroutines at once.
+type fetchPageFn[T any] func(ctx context.Context, page int) (items []T, totalPages int, err error)
+
+// pageResult is a fetched page, or the error fetching it.
+type pageResult[T any] struct {
+	page  int
+	items []T
+	err   error
+}
+
+// walkPages fetches page 1 to learn the number of pages, then fetches the
+// remaining pages with up to pageWorkers workers and calls yield with each
+// page as it arrives, not necessarily in order. It stops, cancelling the
+// pending fetches, when yield returns false or a fetch fails.
+func walkPages[T any](ctx context.Context, fetch fetchPageFn[T], yield func(page int, items []T) bool) error {
+	items, totalPages, err := fetch(ctx, 1)
+	if err != nil {
+		return err
+	}
+
+	if !yield(1, items) || totalPages <= 1 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make(chan int)
+	go func() {
+		defer close(pages)
+		for page := 2; page <= totalPages; page++ {
+			select {
+			case pages <- page:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make(chan pageResult[T])
+	var wg sync.WaitGroup
+	for range min(pageWorkers, totalPages-1) {
+		wg.Go(func() {
+			for page := range pages {
+				items, _, err := fetch(ctx, page)
+				select {
+				case results <- pageResult[T]{page: page, items: items, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		})
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for r := range results {
+		if r.err != nil {
+			return r.err
+		}
+
+		if !yield(r.page, r.items) {
+			return nil
+		}
+	}
+
+	// The workers stop early without an error only when ctx is done.
+	return ctx.Err()
+}
+
+// Pages returns an iterator over the items of every page fetch returns. Pages
+// after the first are fetched concurrently and their items are yielded as
+// they arrive, so the order is not stable. Breaking out of the loop cancels
+// the pending fetches. When a fetch fails, the error is yielded once as the
+// last value.
+func Pages[T any](ctx context.Context, fetch fetchPageFn[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		err := walkPages(ctx, fetch, func(_ int, items []T) bool {
+			for _, item := range items {
+				if !yield(item, nil) {
+					return false
+				}
+			}
+
+			return true
+		})
+		if err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// AllPages returns the items of every page fetch returns, in page order.
+// Pages after the first are fetched concurrently.
+func AllPages[T any](ctx context.Context, fetch fetchPageFn[T]) ([]T, error) {
+	var pages [][]T
+	err := walkPages(ctx, fetch, func(page int, items []T) bool {
+		if page > len(pages) {
+			pages = append(pages, make([][]T, page-len(pages))...)
+		}
+		pages[page-1] = items
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(pages...), nil
+}
+
+// MapConcurrently returns fn applied to each of items, in order. Up to
+// pageWorkers items are processed at the same time, and the first error
+// cancels the pending ones. fn is called from several goroutines at once.
+func MapConcurrently[T, R any](ctx context.Context, items []T, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	return AllPages(ctx, func(ctx context.Context, page int) ([]R, int, error) {
+		result, err := fn(ctx, items[page-1])
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return []R{result}, len(items), nil
+	})
+}
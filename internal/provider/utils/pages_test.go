@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// numberPages returns a fetch function serving totalPages pages of perPage
+// consecutive numbers, along with the number of pages fetched so far and the
+// most pages fetched at once.
+func numberPages(totalPages, perPage int) (fetchPageFn[int], *atomic.Int32, *atomic.Int32) {
+	var fetched, running, peak atomic.Int32
+	fetch := func(ctx context.Context, page int) ([]int, int, error) {
+		fetched.Add(1)
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		// Later pages answer first, so items arrive out of order.
+		select {
+		case <-time.After(time.Duration(totalPages-page) * time.Millisecond):
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		}
+
+		items := make([]int, perPage)
+		for i := range items {
+			items[i] = (page-1)*perPage + i
+		}
+		return items, totalPages, nil
+	}
+
+	return fetch, &fetched, &peak
+}
+
+func TestAllPages(t *testing.T) {
+	fetch, fetched, peak := numberPages(20, 3)
+
+	got, err := AllPages(t.Context(), fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]int, 60)
+	for i := range want {
+		want[i] = i
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if fetched.Load() != 20 {
+		t.Errorf("fetched %d pages, want 20", fetched.Load())
+	}
+	if peak.Load() > pageWorkers {
+		t.Errorf("fetched %d pages at once, want at most %d", peak.Load(), pageWorkers)
+	}
+}
+
+func TestPages(t *testing.T) {
+	fetch, _, _ := numberPages(10, 2)
+
+	var got []int
+	for item, err := range Pages(t.Context(), fetch) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	slices.Sort(got)
+	if len(got) != 20 || got[0] != 0 || got[19] != 19 {
+		t.Errorf("got %v, want every item", got)
+	}
+}
+
+func TestPages_stop(t *testing.T) {
+	fetch, fetched, _ := numberPages(100, 10)
+
+	for item, err := range Pages(t.Context(), fetch) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if item >= 10 {
+			break
+		}
+	}
+
+	if n := fetched.Load(); n > 1+2*pageWorkers {
+		t.Errorf("fetched %d pages after stopping, want the remaining pages skipped", n)
+	}
+}
+
+func TestPages_error(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(_ context.Context, page int) ([]int, int, error) {
+		if page == 3 {
+			return nil, 0, boom
+		}
+		return []int{page}, 5, nil
+	}
+
+	var errs int
+	for _, err := range Pages(t.Context(), fetch) {
+		if err != nil {
+			if !errors.Is(err, boom) {
+				t.Errorf("got %v, want %v", err, boom)
+			}
+			errs++
+		}
+	}
+	if errs != 1 {
+		t.Errorf("got %d errors, want 1", errs)
+	}
+
+	if _, err := AllPages(t.Context(), fetch); !errors.Is(err, boom) {
+		t.Errorf("got %v, want %v", err, boom)
+	}
+}
+
+func TestMapConcurrently(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	var running, peak atomic.Int32
+	got, err := MapConcurrently(t.Context(), items, func(_ context.Context, item int) (int, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		// Later items answer first.
+		time.Sleep(time.Duration(len(items)-item) * time.Millisecond)
+		return item * 2, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range got {
+		if v != i*2 {
+			t.Fatalf("got %v, want the items doubled in order", got)
+		}
+	}
+	if len(got) != len(items) {
+		t.Fatalf("got %d results, want %d", len(got), len(items))
+	}
+	if p := peak.Load(); p > pageWorkers {
+		t.Errorf("processed %d items at once, want at most %d", p, pageWorkers)
+	}
+
+	boom := errors.New("boom")
+	if _, err := MapConcurrently(t.Context(), items, func(_ context.Context, item int) (int, error) {
+		if item == 7 {
+			return 0, boom
+		}
+		return item, nil
+	}); !errors.Is(err, boom) {
+		t.Errorf("got %v, want %v", err, boom)
+	}
+}
@@ -0,0 +1,246 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+// The helpers below run from ModifyPlan, so owners, maintainers and approvers
+// configured by email or group name plan with their ID, and both end up in
+// state.
+
+// AddLookupError adds the attribute error of a failed user or directory group
+// lookup, kind being "user" or "directory group".
+func AddLookupError(diags *diag.Diagnostics, p path.Path, kind string, err error) {
+	title := strings.ToUpper(kind[:1]) + kind[1:]
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		diags.AddAttributeError(p, "Unknown "+title, err.Error())
+	case errors.Is(err, ErrAmbiguousMatch):
+		diags.AddAttributeError(p, "Ambiguous "+title, err.Error())
+	default:
+		diags.AddAttributeError(p, ErrApiConnection.Error(), err.Error())
+	}
+}
+
+// ResolveOwnerAndMaintainers resolves the "owner" and "maintainers"
+// attributes of integrations and resources.
+func ResolveOwnerAndMaintainers(ctx context.Context, c *client.ClientWithResponses, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy, or before the provider is configured.
+	if c == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(ResolveOwner(ctx, c, path.Root("owner"), req.Config, req.State, &resp.Plan)...)
+	resp.Diagnostics.Append(ResolveMaintainers(ctx, c, path.Root("maintainers"), req.Config, &resp.Plan)...)
+}
+
+// ResolveOwner plans the ID of the owner object at p when the configuration
+// gives its email only, and plans an unknown email when the configured ID
+// differs from the one in state, as the email kept from state would be stale.
+func ResolveOwner(ctx context.Context, c *client.ClientWithResponses, p path.Path, config tfsdk.Config, state tfsdk.State, plan *tfsdk.Plan) diag.Diagnostics {
+	var owner types.Object
+	diags := config.GetAttribute(ctx, p, &owner)
+	if diags.HasError() || owner.IsNull() || owner.IsUnknown() {
+		return diags
+	}
+
+	id, _ := owner.Attributes()["id"].(types.String)
+	email, _ := owner.Attributes()["email"].(types.String)
+
+	if !id.IsNull() {
+		if id.IsUnknown() || !email.IsNull() {
+			return diags
+		}
+
+		var prior types.Object
+		if !state.Raw.IsNull() {
+			diags.Append(state.GetAttribute(ctx, p, &prior)...)
+		}
+		if prior.IsNull() || prior.IsUnknown() || !prior.Attributes()["id"].Equal(id) {
+			diags.Append(plan.SetAttribute(ctx, p.AtName("email"), types.StringUnknown())...)
+		}
+
+		return diags
+	}
+	if email.IsNull() || email.IsUnknown() {
+		return diags
+	}
+
+	userID, err := FindUserIDByEmail(ctx, c, email.ValueString())
+	if err != nil {
+		AddLookupError(&diags, p.AtName("email"), "user", err)
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, p.AtName("id"), types.StringValue(userID.String()))...)
+
+	return diags
+}
+
+// ResolveMaintainers plans the entity ID of the maintainers in the set at p
+// that the configuration gives by email only. Group maintainers are looked up
+// by the group's email.
+func ResolveMaintainers(ctx context.Context, c *client.ClientWithResponses, p path.Path, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var configured types.Set
+	diags := config.GetAttribute(ctx, p, &configured)
+	if diags.HasError() || configured.IsNull() || configured.IsUnknown() {
+		return diags
+	}
+
+	// The emails of the maintainers configured without an ID.
+	emails := map[string]bool{}
+	for _, elem := range configured.Elements() {
+		id, email := maintainerEntity(elem)
+		if id.IsNull() && !email.IsNull() && !email.IsUnknown() {
+			emails[strings.ToLower(email.ValueString())] = true
+		}
+	}
+	if len(emails) == 0 {
+		return diags
+	}
+
+	var planned types.Set
+	diags.Append(plan.GetAttribute(ctx, p, &planned)...)
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+
+	elems := make([]attr.Value, 0, len(planned.Elements()))
+	for _, elem := range planned.Elements() {
+		_, email := maintainerEntity(elem)
+		if email.IsNull() || email.IsUnknown() || !emails[strings.ToLower(email.ValueString())] {
+			elems = append(elems, elem)
+			continue
+		}
+
+		maintainer := elem.(types.Object).Attributes()
+		entity := maintainer["entity"].(types.Object)
+		errPath := p.AtSetValue(elem).AtName("entity").AtName("email")
+
+		var id string
+		if maintainerType, _ := maintainer["type"].(types.String); maintainerType.ValueString() == MaintainerTypeGroup {
+			groupID, err := FindDirectoryGroupID(ctx, c, email.ValueString(), true)
+			if err != nil {
+				AddLookupError(&diags, errPath, "directory group", err)
+				continue
+			}
+			id = groupID.String()
+		} else {
+			userID, err := FindUserIDByEmail(ctx, c, email.ValueString())
+			if err != nil {
+				AddLookupError(&diags, errPath, "user", err)
+				continue
+			}
+			id = userID.String()
+		}
+
+		entityAttributes := entity.Attributes()
+		entityAttributes["id"] = types.StringValue(id)
+		resolvedEntity, d := types.ObjectValue(entity.AttributeTypes(ctx), entityAttributes)
+		diags.Append(d...)
+
+		maintainer["entity"] = resolvedEntity
+		resolved, d := types.ObjectValue(elem.(types.Object).AttributeTypes(ctx), maintainer)
+		diags.Append(d...)
+
+		elems = append(elems, resolved)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	resolved, d := types.SetValue(planned.ElementType(ctx), elems)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, p, resolved)...)
+
+	return diags
+}
+
+// maintainerEntity returns the ID and email of a maintainer set element.
+func maintainerEntity(elem attr.Value) (types.String, types.String) {
+	maintainer, ok := elem.(types.Object)
+	if !ok || maintainer.IsNull() || maintainer.IsUnknown() {
+		return types.StringUnknown(), types.StringUnknown()
+	}
+
+	entity, ok := maintainer.Attributes()["entity"].(types.Object)
+	if !ok || entity.IsNull() || entity.IsUnknown() {
+		return types.StringUnknown(), types.StringUnknown()
+	}
+
+	id, _ := entity.Attributes()["id"].(types.String)
+	email, _ := entity.Attributes()["email"].(types.String)
+
+	return id, email
+}
+
+// ResolveMaintainerEntity plans the ID of the "entity" of a maintainer
+// attachment when the configuration gives its email only, looking up a user
+// or, when "type" is group, a directory group. The attachment is replaced
+// when the planned ID is not the one in state.
+func ResolveMaintainerEntity(ctx context.Context, c *client.ClientWithResponses, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy, or before the provider is configured.
+	if c == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer requireMaintainerEntityReplace(ctx, req, resp)
+
+	var maintainerType, id, email types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &maintainerType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entity").AtName("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entity").AtName("email"), &email)...)
+	if resp.Diagnostics.HasError() || !id.IsNull() || email.IsNull() || email.IsUnknown() || maintainerType.IsUnknown() {
+		return
+	}
+
+	p := path.Root("entity").AtName("email")
+	var resolved *uuid.UUID
+	var err error
+	if maintainerType.ValueString() == MaintainerTypeGroup {
+		resolved, err = FindDirectoryGroupID(ctx, c, email.ValueString(), true)
+		if err != nil {
+			AddLookupError(&resp.Diagnostics, p, "directory group", err)
+			return
+		}
+	} else {
+		resolved, err = FindUserIDByEmail(ctx, c, email.ValueString())
+		if err != nil {
+			AddLookupError(&resp.Diagnostics, p, "user", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entity").AtName("id"), types.StringValue(resolved.String()))...)
+}
+
+// requireMaintainerEntityReplace replaces a maintainer attachment whose
+// planned entity ID is not the one in state.
+func requireMaintainerEntityReplace(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior, planned types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("entity").AtName("id"), &prior)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("entity").AtName("id"), &planned)...)
+	if !resp.Diagnostics.HasError() && !strings.EqualFold(prior.ValueString(), planned.ValueString()) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("entity").AtName("id"))
+	}
+}
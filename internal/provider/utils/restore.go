@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The private state keys of the settings a synced resource or role had before
+// Terraform adopted it, and of the marker an import leaves for the next read
+// to take that snapshot.
+const (
+	restoreSnapshotKey = "restore_snapshot"
+	restorePendingKey  = "restore_pending"
+)
+
+// PrivateState is the private state of a resource, as found in the requests
+// and responses of its operations.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// RestoreOnDestroyAttribute returns the schema of the restore_on_destroy
+// attribute of the synced resources, named by kind.
+func RestoreOnDestroyAttribute(kind string) schema.BoolAttribute {
+	description := fmt.Sprintf("When true, destroying this %s sends back the settings it had before Terraform "+
+		"adopted it, at create or import time, instead of only removing it from state. Defaults to false.", kind)
+
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+// RestoreOnDestroy returns the value of restore_on_destroy, false when unset,
+// as it is after an import.
+func RestoreOnDestroy(value types.Bool) types.Bool {
+	if value.IsNull() || value.IsUnknown() {
+		return types.BoolValue(false)
+	}
+
+	return value
+}
+
+// SaveRestoreSnapshot saves the update request body that restores the
+// settings of a synced resource or role into its private state.
+func SaveRestoreSnapshot(ctx context.Context, private PrivateState, body any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	snapshot, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to encode the settings to restore on destroy: %v", err))
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, restoreSnapshotKey, snapshot)...)
+	diags.Append(private.SetKey(ctx, restorePendingKey, nil)...)
+
+	return diags
+}
+
+// LoadRestoreSnapshot decodes the snapshot saved by SaveRestoreSnapshot into
+// body, and reports whether there was one.
+func LoadRestoreSnapshot(ctx context.Context, private PrivateState, body any) (bool, diag.Diagnostics) {
+	snapshot, diags := private.GetKey(ctx, restoreSnapshotKey)
+	if diags.HasError() || len(snapshot) == 0 {
+		return false, diags
+	}
+
+	if err := json.Unmarshal(snapshot, body); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to decode the settings to restore on destroy: %v", err))
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// MarkRestorePending records in the private state of an imported synced
+// resource or role that the next read takes its restore snapshot.
+func MarkRestorePending(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return private.SetKey(ctx, restorePendingKey, []byte("true"))
+}
+
+// IsRestorePending reports whether MarkRestorePending was called and no
+// snapshot was saved since.
+func IsRestorePending(ctx context.Context, private PrivateState) (bool, diag.Diagnostics) {
+	pending, diags := private.GetKey(ctx, restorePendingKey)
+
+	return len(pending) > 0, diags
+}
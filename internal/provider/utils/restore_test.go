@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+// privateState is a PrivateState in memory, removing keys set to nil as the
+// framework does.
+type privateState map[string][]byte
+
+func (p privateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	private := privateState{}
+
+	var request client.RolesUpdateJSONRequestBody
+	if found, diags := LoadRestoreSnapshot(ctx, private, &request); found || diags.HasError() {
+		t.Fatalf("got %v, %v without a snapshot", found, diags)
+	}
+
+	diags := MarkRestorePending(ctx, private)
+	if pending, d := IsRestorePending(ctx, private); !pending || diags.HasError() || d.HasError() {
+		t.Fatalf("got pending %v after an import", pending)
+	}
+
+	requestable := false
+	durations := []client.EnumAllowedDurations{3600, -1}
+	workflowID := uuid.New()
+	if diags := SaveRestoreSnapshot(ctx, private, client.RolesUpdateJSONRequestBody{
+		Requestable:      &requestable,
+		AllowedDurations: &durations,
+		Workflow:         &client.IdParamsSchema{Id: workflowID},
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if pending, _ := IsRestorePending(ctx, private); pending {
+		t.Fatal("still pending after the snapshot")
+	}
+
+	found, diags := LoadRestoreSnapshot(ctx, private, &request)
+	if !found || diags.HasError() {
+		t.Fatalf("got %v, %v", found, diags)
+	}
+	if request.Requestable == nil || *request.Requestable ||
+		request.AllowedDurations == nil || !AllowedDurationsEqual(*request.AllowedDurations, durations) ||
+		request.Workflow == nil || request.Workflow.Id != workflowID {
+		t.Fatalf("got %+v", request)
+	}
+}
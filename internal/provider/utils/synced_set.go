@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Synced sets apply common settings to the synced items, resources or roles,
+// that match a selector. They all reconcile the same way:
+//
+//   - The plan lists the matching items into the items map of the set, their
+//     names by their ids, so it shows the items joining and leaving the set.
+//   - Refresh keeps the items of state that still exist and still have the
+//     settings of the set, so items whose settings drifted are planned to
+//     join it again.
+//   - Apply updates the planned items concurrently: all of them when the
+//     settings changed, otherwise the ones missing from state only.
+
+// SyncedSetItems returns the items of a synced set from their names by their
+// ids.
+func SyncedSetItems(names map[string]string) (types.Map, diag.Diagnostics) {
+	items := make(map[string]attr.Value, len(names))
+	for id, name := range names {
+		items[id] = types.StringValue(name)
+	}
+
+	return types.MapValue(types.StringType, items)
+}
+
+// ApplySyncedSet updates the planned items of a synced set with update, and
+// returns the items of the set once applied. The items of prior are skipped
+// unless settingsChanged. Every item is attempted: the failed ones are
+// reported as errors and left out of the returned items, so the next plan
+// updates them again, while the updated ones are returned along.
+func ApplySyncedSet(ctx context.Context, kind string, planned, prior types.Map, settingsChanged bool, update func(ctx context.Context, id uuid.UUID) error) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	applied := map[string]attr.Value{}
+	var ids []string
+	for id, name := range planned.Elements() {
+		if _, ok := prior.Elements()[id]; ok && !settingsChanged {
+			applied[id] = name
+			continue
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	errs, _ := MapConcurrently(ctx, ids, func(ctx context.Context, id string) (error, error) {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("failed to parse the id to UUID: %w", err), nil
+		}
+
+		return update(ctx, uid), nil
+	})
+
+	for i, id := range ids {
+		name := planned.Elements()[id]
+		if errs[i] != nil {
+			diags.AddError(
+				ErrApiResponse.Error(),
+				fmt.Sprintf("Failed to update the %s %s (%s): %s", kind, name, id, errs[i]),
+			)
+			continue
+		}
+		applied[id] = name
+	}
+
+	items, d := types.MapValue(types.StringType, applied)
+	diags.Append(d...)
+
+	return items, diags
+}
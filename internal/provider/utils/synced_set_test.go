@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestApplySyncedSet(t *testing.T) {
+	kept, joined, failed := uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+	planned, diags := SyncedSetItems(map[string]string{kept: "kept", joined: "joined", failed: "failed"})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	prior, diags := SyncedSetItems(map[string]string{kept: "kept", uuid.NewString(): "left"})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var mu sync.Mutex
+	var updated []string
+	update := func(ctx context.Context, id uuid.UUID) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		updated = append(updated, id.String())
+		if id.String() == failed {
+			return errors.New("boom")
+		}
+		return nil
+	}
+
+	// With unchanged settings, the items in state are skipped, and a failed
+	// item does not stop the others.
+	items, diags := ApplySyncedSet(t.Context(), "resource", planned, prior, false, update)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+	}
+	want := []string{joined, failed}
+	slices.Sort(want)
+	slices.Sort(updated)
+	if !slices.Equal(updated, want) {
+		t.Errorf("updated %v, want %v", updated, want)
+	}
+	if _, ok := items.Elements()[failed]; ok || len(items.Elements()) != 2 {
+		t.Errorf("got items %v, want the kept and joined items", items)
+	}
+
+	// With changed settings, every planned item is updated.
+	updated = nil
+	items, diags = ApplySyncedSet(t.Context(), "resource", planned, prior, true, func(ctx context.Context, id uuid.UUID) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		updated = append(updated, id.String())
+		return nil
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(updated) != 3 || !items.Equal(planned) {
+		t.Errorf("updated %v into %v, want every planned item", updated, items)
+	}
+}
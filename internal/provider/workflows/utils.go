@@ -7,11 +7,14 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/entitleio/terraform-provider-entitle/internal/client"
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+	"github.com/entitleio/terraform-provider-entitle/internal/validators"
 )
 
 func getWorkflowsRules(
@@ -999,3 +1002,229 @@ func convertFullWorkflowResultResponseSchemaToModel(
 		Rules: w.Rules,
 	}, diags
 }
+
+// resolveEntities plans the ID of the users and groups that the approval and
+// notified entities of the configuration give by email or name only. The
+// configuration is walked as attribute values, since parts of it may be
+// unknown until apply.
+func resolveEntities(ctx context.Context, c *client.ClientWithResponses, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var rules types.List
+	diags := config.GetAttribute(ctx, path.Root("rules"), &rules)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, rule := range knownObjects(rules) {
+		flow, ok := rule.Attributes()["approval_flow"].(types.Object)
+		if !ok || flow.IsNull() || flow.IsUnknown() {
+			continue
+		}
+
+		steps, _ := flow.Attributes()["steps"].(types.List)
+		for j, step := range knownObjects(steps) {
+			stepPath := path.Root("rules").AtListIndex(i).AtName("approval_flow").AtName("steps").AtListIndex(j)
+
+			for _, name := range []string{"approval_entities", "notified_entities"} {
+				entities, _ := step.Attributes()[name].(types.List)
+				for k, entity := range knownObjects(entities) {
+					diags.Append(resolveEntity(ctx, c, stepPath.AtName(name).AtListIndex(k), entity, plan)...)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+// resolveEntity plans the ID of the user given by email or the group given by
+// name of an entity at p.
+func resolveEntity(ctx context.Context, c *client.ClientWithResponses, p path.Path, entity types.Object, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if user, ok := entity.Attributes()["user"].(types.Object); ok && !user.IsNull() && !user.IsUnknown() {
+		id, _ := user.Attributes()["id"].(types.String)
+		email, _ := user.Attributes()["email"].(types.String)
+		if id.IsNull() && !email.IsNull() && !email.IsUnknown() {
+			userID, err := utils.FindUserIDByEmail(ctx, c, email.ValueString())
+			if err != nil {
+				utils.AddLookupError(&diags, p.AtName("user").AtName("email"), "user", err)
+				return diags
+			}
+
+			diags.Append(plan.SetAttribute(ctx, p.AtName("user").AtName("id"), types.StringValue(userID.String()))...)
+		}
+	}
+
+	if group, ok := entity.Attributes()["group"].(types.Object); ok && !group.IsNull() && !group.IsUnknown() {
+		id, _ := group.Attributes()["id"].(types.String)
+		name, _ := group.Attributes()["name"].(types.String)
+		if id.IsNull() && !name.IsNull() && !name.IsUnknown() {
+			groupID, err := utils.FindDirectoryGroupID(ctx, c, name.ValueString(), false)
+			if err != nil {
+				utils.AddLookupError(&diags, p.AtName("group").AtName("name"), "directory group", err)
+				return diags
+			}
+
+			diags.Append(plan.SetAttribute(ctx, p.AtName("group").AtName("id"), types.StringValue(groupID.String()))...)
+		}
+	}
+
+	return diags
+}
+
+// knownObjects returns the known object elements of a list by index.
+func knownObjects(list types.List) map[int]types.Object {
+	objects := map[int]types.Object{}
+	if list.IsNull() || list.IsUnknown() {
+		return objects
+	}
+
+	for i, elem := range list.Elements() {
+		if object, ok := elem.(types.Object); ok && !object.IsNull() && !object.IsUnknown() {
+			objects[i] = object
+		}
+	}
+
+	return objects
+}
+
+// validateRules checks the structure of the configured rules: unique sort
+// orders, allowed durations and well formed approval and notified entities.
+// Unknown values are skipped, they are validated again once known.
+func validateRules(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var rules types.List
+	diags := config.GetAttribute(ctx, path.Root("rules"), &rules)
+	if diags.HasError() {
+		return diags
+	}
+
+	ruleOrders := map[string]bool{}
+	for i, rule := range knownObjects(rules) {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		validateSortOrder(&diags, rulePath.AtName("sort_order"), rule, ruleOrders, "rule")
+
+		if underDuration, _ := rule.Attributes()["under_duration"].(types.Number); !underDuration.IsNull() && !underDuration.IsUnknown() {
+			f, _ := underDuration.ValueBigFloat().Float64()
+			if !validators.IsAllowedDuration(f) {
+				diags.AddAttributeError(
+					rulePath.AtName("under_duration"),
+					"Invalid Allowed Duration",
+					fmt.Sprintf("%s is not an allowed duration, expected one of %s.", underDuration.ValueBigFloat().Text('f', -1), validators.AllowedDurationValues()),
+				)
+			}
+		}
+
+		flow, ok := rule.Attributes()["approval_flow"].(types.Object)
+		if !ok || flow.IsNull() || flow.IsUnknown() {
+			continue
+		}
+
+		steps, _ := flow.Attributes()["steps"].(types.List)
+		stepOrders := map[string]bool{}
+		for j, step := range knownObjects(steps) {
+			stepPath := rulePath.AtName("approval_flow").AtName("steps").AtListIndex(j)
+
+			validateSortOrder(&diags, stepPath.AtName("sort_order"), step, stepOrders, "step")
+
+			approvalEntities, _ := step.Attributes()["approval_entities"].(types.List)
+			for k, entity := range knownObjects(approvalEntities) {
+				entityPath := stepPath.AtName("approval_entities").AtListIndex(k)
+				entityType := validateEntity(&diags, entityPath, entity, true)
+
+				if entityType == string(client.EnumApprovalEntityWithoutEntityAutomatic) && len(approvalEntities.Elements()) > 1 {
+					diags.AddAttributeError(
+						entityPath.AtName("type"),
+						"Invalid Automatic Approval",
+						"A step with an Automatic approval entity cannot have other approval entities.",
+					)
+				}
+			}
+
+			notifiedEntities, _ := step.Attributes()["notified_entities"].(types.List)
+			for k, entity := range knownObjects(notifiedEntities) {
+				validateEntity(&diags, stepPath.AtName("notified_entities").AtListIndex(k), entity, false)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateSortOrder checks the sort_order of a rule or step is not in seen
+// already, and adds it. An unset sort_order counts as its default, 0.
+func validateSortOrder(diags *diag.Diagnostics, p path.Path, object types.Object, seen map[string]bool, kind string) {
+	sortOrder, _ := object.Attributes()["sort_order"].(types.Number)
+	if sortOrder.IsUnknown() {
+		return
+	}
+
+	key := sortOrderKey(sortOrder)
+	if seen[key] {
+		diags.AddAttributeError(
+			p,
+			"Duplicate Sort Order",
+			fmt.Sprintf("Another %s has sort_order %s, the sort_order of each %s must be unique.", kind, key, kind),
+		)
+	}
+	seen[key] = true
+}
+
+// validateEntity checks the type of an approval or notified entity, and that
+// the nested object of that type is the only one set. The lowercase aliases of
+// the types are accepted too. It returns the type an alias stands for, or ""
+// when unknown.
+func validateEntity(diags *diag.Diagnostics, p path.Path, entity types.Object, approval bool) string {
+	entityType, _ := entity.Attributes()["type"].(types.String)
+	if entityType.IsUnknown() {
+		return ""
+	}
+	if entityType.IsNull() {
+		diags.AddAttributeError(p.AtName("type"), "Missing Workflow Entity Type", "The type of the entity must be set.")
+		return ""
+	}
+
+	typeName := entityType.ValueString()
+	if alias, ok := utils.WorkflowEntityTypeAliases[typeName]; ok {
+		typeName = alias
+	}
+
+	nested, ok := utils.WorkflowEntityTypes[typeName]
+	if !ok || (!approval && typeName == string(client.EnumApprovalEntityWithoutEntityAutomatic)) {
+		names := utils.WorkflowEntityTypeNames()
+		if !approval {
+			names = strings.Replace(names, string(client.EnumApprovalEntityWithoutEntityAutomatic)+", ", "", 1)
+		}
+
+		diags.AddAttributeError(
+			p.AtName("type"),
+			"Invalid Workflow Entity Type",
+			fmt.Sprintf("%q is not an entity type, expected one of %s.", entityType.ValueString(), names),
+		)
+
+		return ""
+	}
+
+	for name, value := range entity.Attributes() {
+		if name == "type" || value.IsUnknown() {
+			continue
+		}
+
+		switch {
+		case name == nested && value.IsNull():
+			diags.AddAttributeError(
+				p.AtName(name),
+				"Missing Workflow Entity",
+				fmt.Sprintf("An entity of type %s requires %s to be set.", entityType.ValueString(), name),
+			)
+		case name != nested && !value.IsNull():
+			diags.AddAttributeError(
+				p.AtName(name),
+				"Unexpected Workflow Entity",
+				fmt.Sprintf("An entity of type %s cannot set %s.", entityType.ValueString(), name),
+			)
+		}
+	}
+
+	return typeName
+}
@@ -8,7 +8,11 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
 )
@@ -1490,3 +1494,97 @@ func TestReconcileEntityOrder_ChannelAndGroupMixed(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateRules verifies the plan-time checks of the rule structure.
+func TestValidateRules(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewWorkflowResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	entity := func(entityType string, nested string, value types.Object) *workflowRulesApprovalFlowStepApprovalNotifiedModel {
+		e := &workflowRulesApprovalFlowStepApprovalNotifiedModel{
+			Type:     types.StringValue(entityType),
+			User:     types.ObjectNull(utils.IdEmailModel{}.AttributeTypes()),
+			Group:    types.ObjectNull(utils.IdNameModel{}.AttributeTypes()),
+			Schedule: types.ObjectNull(utils.IdNameModel{}.AttributeTypes()),
+			Webhook:  types.ObjectNull(utils.IdNameModel{}.AttributeTypes()),
+			Channel:  types.ObjectNull(utils.IdentityOnlyModel{}.AttributeTypes()),
+		}
+		switch nested {
+		case "user":
+			e.User = value
+		case "group":
+			e.Group = value
+		}
+		return e
+	}
+	user := types.ObjectValueMust(utils.IdEmailModel{}.AttributeTypes(), map[string]attr.Value{
+		"id":    types.StringValue("aaaaaaaa-1111-2222-3333-444444444444"),
+		"email": types.StringNull(),
+	})
+	group := types.ObjectValueMust(utils.IdNameModel{}.AttributeTypes(), map[string]attr.Value{
+		"id":   types.StringValue("bbbbbbbb-1111-2222-3333-444444444444"),
+		"name": types.StringNull(),
+	})
+	rule := func(sortOrder, underDuration float64, steps ...*workflowRulesApprovalFlowStepModel) *workflowRulesModel {
+		return &workflowRulesModel{
+			SortOrder:     types.NumberValue(big.NewFloat(sortOrder)),
+			UnderDuration: types.NumberValue(big.NewFloat(underDuration)),
+			AnySchedule:   types.BoolValue(true),
+			ApprovalFlow:  &workflowRulesApprovalFlowModel{Steps: steps},
+		}
+	}
+	step := func(sortOrder float64, entities ...*workflowRulesApprovalFlowStepApprovalNotifiedModel) *workflowRulesApprovalFlowStepModel {
+		return &workflowRulesApprovalFlowStepModel{
+			SortOrder:        types.NumberValue(big.NewFloat(sortOrder)),
+			Operator:         types.StringValue("or"),
+			ApprovalEntities: entities,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		rules []*workflowRulesModel
+		want  string
+	}{
+		"valid": {
+			rules: []*workflowRulesModel{
+				rule(1, 3600, step(1, entity("User", "user", user), entity("DirectManager", "", types.Object{})), step(2, entity("Automatic", "", types.Object{}))),
+				rule(2, 43200, step(1, entity("ResourceOwner", "", types.Object{}))),
+			},
+		},
+		"unknown type":          {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("Manager", "", types.Object{})))}, want: "Invalid Workflow Entity Type"},
+		"lowercase alias":       {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("user", "user", user), entity("group", "group", group)))}},
+		"alias missing nested":  {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("group", "user", user)))}, want: "Missing Workflow Entity"},
+		"lowercase type":        {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("directManager", "", types.Object{})))}, want: "Invalid Workflow Entity Type"},
+		"missing nested":        {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("User", "", types.Object{})))}, want: "Missing Workflow Entity"},
+		"unexpected nested":     {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("DirectManager", "user", user)))}, want: "Unexpected Workflow Entity"},
+		"wrong nested":          {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("DirectoryGroup", "user", user)))}, want: "Missing Workflow Entity"},
+		"duplicate rule order":  {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("Automatic", "", types.Object{}))), rule(1, 7200, step(1, entity("Automatic", "", types.Object{})))}, want: "Duplicate Sort Order"},
+		"duplicate step order":  {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("DirectManager", "", types.Object{})), step(1, entity("ResourceOwner", "", types.Object{})))}, want: "Duplicate Sort Order"},
+		"invalid duration":      {rules: []*workflowRulesModel{rule(1, 1234, step(1, entity("Automatic", "", types.Object{})))}, want: "Invalid Allowed Duration"},
+		"automatic with others": {rules: []*workflowRulesModel{rule(1, 3600, step(1, entity("Automatic", "", types.Object{}), entity("DirectManager", "", types.Object{})))}, want: "Invalid Automatic Approval"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if diags := state.SetAttribute(ctx, path.Root("rules"), tc.rules); diags.HasError() {
+				t.Fatalf("failed to build the config: %s", diags.Errors())
+			}
+
+			diags := validateRules(ctx, tfsdk.Config{Schema: state.Schema, Raw: state.Raw})
+			if tc.want == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected errors: %s", diags.Errors())
+				}
+				return
+			}
+
+			for _, d := range diags.Errors() {
+				if d.Summary() == tc.want {
+					return
+				}
+			}
+			t.Fatalf("got %v, want a %q error", diags.Errors(), tc.want)
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"net/http"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -400,7 +399,7 @@ func (d *WorkflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if data.Id.ValueString() == "" {
 		name := data.Name.ValueString()
 
-		id, err := d.getWorkflowIDByName(ctx, name)
+		id, err := utils.FindWorkflowIDByName(ctx, d.client, name)
 		if err != nil {
 			resp.Diagnostics.AddError("Workflow not found", fmt.Sprintf(
 				"Failed to get the Workflow by the name (%s), %s",
@@ -425,7 +424,7 @@ func (d *WorkflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	err = utils.HTTPResponseToError(workflowResp.HTTPResponse.StatusCode, workflowResp.Body)
+	err = utils.ResponseToError(workflowResp.HTTPResponse, workflowResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -455,36 +454,6 @@ func (d *WorkflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 }
 
-func (d *WorkflowDataSource) getWorkflowIDByName(ctx context.Context, name string) (*openapi_types.UUID, error) {
-	fetch := func(ctx context.Context, page int) ([]client.WorkflowIndexResultResponseSchema, int, error) {
-		params := client.WorkflowsIndexParams{
-			PerPage: utils.Float32Pointer(100),
-			Page:    utils.Float32Pointer(float32(page)),
-			Search:  utils.StringPointer(name),
-		}
-
-		resp, err := d.client.WorkflowsIndexWithResponse(ctx, &params)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list workflows: %w", err)
-		}
-
-		if resp.HTTPResponse.StatusCode >= http.StatusBadRequest {
-			return nil, 0, fmt.Errorf("API returned status %d while listing workflows (page %d)",
-				resp.HTTPResponse.StatusCode, page)
-		}
-
-		if resp.JSON200 == nil || resp.JSON200.Result == nil {
-			return nil, 0, fmt.Errorf("received invalid workflow response structure (page %d)", page)
-		}
-
-		items := resp.JSON200.Result
-		total := int(resp.JSON200.Pagination.TotalPages)
-		return items, total, nil
-	}
-
-	return utils.FindIDByName(ctx, name, fetch)
-}
-
 func converterWorkflow(
 	ctx context.Context,
 	data *client.FullWorkflowResultResponseSchema,
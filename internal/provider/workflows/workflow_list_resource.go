@@ -0,0 +1,109 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/docs"
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &WorkflowListResource{}
+var _ list.ListResourceWithConfigure = &WorkflowListResource{}
+
+// NewWorkflowListResource creates a new instance of the WorkflowListResource.
+func NewWorkflowListResource() list.ListResource {
+	return &WorkflowListResource{}
+}
+
+// WorkflowListResource lists workflows for Terraform query.
+type WorkflowListResource struct {
+	client *client.ClientWithResponses
+}
+
+// WorkflowListResourceModel describes the list resource configuration.
+type WorkflowListResourceModel struct {
+	Search types.String `tfsdk:"search"`
+}
+
+// Metadata sets the metadata for the list resource, which lists entitle_workflow resources.
+func (r *WorkflowListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *WorkflowListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: docs.WorkflowListResourceMarkdownDescription,
+		Attributes: map[string]listschema.Attribute{
+			"search": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Search string to filter workflows by name.",
+			},
+		},
+	}
+}
+
+// Configure configures the list resource with the provided client.
+func (r *WorkflowListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// List streams a result for each workflow matching the configuration, with
+// its identity and the attributes the list endpoint returns.
+func (r *WorkflowListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data WorkflowListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	utils.StreamListResults(ctx, req, stream, utils.IDIdentityPaths,
+		func(ctx context.Context, page int) ([]client.WorkflowIndexResultResponseSchema, int, error) {
+			apiResp, err := r.client.WorkflowsIndexWithResponse(ctx, &client.WorkflowsIndexParams{
+				Page:    utils.Float32Pointer(float32(page)),
+				PerPage: utils.Float32Pointer(100),
+				Search:  data.Search.ValueStringPointer(),
+			})
+			if err != nil {
+				return nil, 0, fmt.Errorf("%w: %w", utils.ErrApiConnection, err)
+			}
+
+			if err := utils.ResponseToError(apiResp.HTTPResponse, apiResp.Body); err != nil {
+				return nil, 0, err
+			}
+
+			return apiResp.JSON200.Result, int(apiResp.JSON200.Pagination.TotalPages), nil
+		},
+		func(ctx context.Context, w client.WorkflowIndexResultResponseSchema, res *tfsdk.Resource) (string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.Append(res.SetAttribute(ctx, path.Root("id"), w.Id.String())...)
+			diags.Append(res.SetAttribute(ctx, path.Root("name"), w.Name)...)
+			return w.Name, diags
+		},
+	)
+}
@@ -35,6 +35,15 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &WorkflowResource{}
 var _ resource.ResourceWithImportState = &WorkflowResource{}
+var _ resource.ResourceWithIdentity = &WorkflowResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowResource{}
+var _ resource.ResourceWithValidateConfig = &WorkflowResource{}
+
+// workflowFieldPaths maps workflow request body fields to their attributes.
+var workflowFieldPaths = utils.APIFieldPaths{
+	"name":  path.Root("name"),
+	"rules": path.Root("rules"),
+}
 
 func NewWorkflowResource() resource.Resource {
 	return &WorkflowResource{}
@@ -194,13 +203,22 @@ func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaReques
 															Attributes: map[string]schema.Attribute{
 																"id": schema.StringAttribute{
 																	Optional:            true,
+																	Computed:            true,
 																	Description:         "Unique identifier of the notified user.",
 																	MarkdownDescription: "Unique identifier of the notified user.",
+																	Validators: []validator.String{
+																		stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+																	},
 																},
 																"email": schema.StringAttribute{
-																	Computed:            true,
-																	Description:         "Email address of the notified user.",
-																	MarkdownDescription: "Email address of the notified user.",
+																	Optional: true,
+																	Computed: true,
+																	Validators: []validator.String{
+																		validators.Email{},
+																		validators.Lowercase{},
+																	},
+																	Description:         "Email address (lowercase) of the notified user, resolved to its id at plan time when id is not set.",
+																	MarkdownDescription: "Email address (lowercase) of the notified user, resolved to its id at plan time when id is not set.",
 																},
 															},
 															Optional:            true,
@@ -211,13 +229,18 @@ func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaReques
 															Attributes: map[string]schema.Attribute{
 																"id": schema.StringAttribute{
 																	Optional:            true,
+																	Computed:            true,
 																	Description:         "A unique identifier of the group",
 																	MarkdownDescription: "A unique identifier of the group",
+																	Validators: []validator.String{
+																		stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("name")),
+																	},
 																},
 																"name": schema.StringAttribute{
+																	Optional:            true,
 																	Computed:            true,
-																	Description:         "Name of the notified group.",
-																	MarkdownDescription: "Name of the notified group.",
+																	Description:         "Name of the notified group, resolved to its id at plan time when id is not set.",
+																	MarkdownDescription: "Name of the notified group, resolved to its id at plan time when id is not set.",
 																},
 															},
 															Optional:            true,
@@ -292,13 +315,22 @@ func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaReques
 															Attributes: map[string]schema.Attribute{
 																"id": schema.StringAttribute{
 																	Optional:            true,
+																	Computed:            true,
 																	Description:         "Unique identifier of the approver.",
 																	MarkdownDescription: "Unique identifier of the approver.",
+																	Validators: []validator.String{
+																		stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("email")),
+																	},
 																},
 																"email": schema.StringAttribute{
-																	Computed:            true,
-																	Description:         "Email address of the approver.",
-																	MarkdownDescription: "Email address of the approver.",
+																	Optional: true,
+																	Computed: true,
+																	Validators: []validator.String{
+																		validators.Email{},
+																		validators.Lowercase{},
+																	},
+																	Description:         "Email address (lowercase) of the approver, resolved to its id at plan time when id is not set.",
+																	MarkdownDescription: "Email address (lowercase) of the approver, resolved to its id at plan time when id is not set.",
 																},
 															},
 															Optional:            true,
@@ -309,13 +341,18 @@ func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaReques
 															Attributes: map[string]schema.Attribute{
 																"id": schema.StringAttribute{
 																	Optional:            true,
+																	Computed:            true,
 																	Description:         "Unique identifier of the approver group.",
 																	MarkdownDescription: "Unique identifier of the approver group.",
+																	Validators: []validator.String{
+																		stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("name")),
+																	},
 																},
 																"name": schema.StringAttribute{
+																	Optional:            true,
 																	Computed:            true,
-																	Description:         "Name of the approver group.",
-																	MarkdownDescription: "Name of the approver group.",
+																	Description:         "Name of the approver group, resolved to its id at plan time when id is not set.",
+																	MarkdownDescription: "Name of the approver group, resolved to its id at plan time when id is not set.",
 																},
 															},
 															Optional:            true,
@@ -419,6 +456,23 @@ func (r *WorkflowResource) Configure(
 	r.client = c
 }
 
+// ValidateConfig checks the rules before they reach the API: entity types and
+// their nested objects, unique sort orders and allowed durations.
+func (r *WorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateRules(ctx, req.Config)...)
+}
+
+// ModifyPlan resolves the users configured by email and the directory groups
+// configured by name in the approval flows to their IDs.
+func (r *WorkflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy, or before the provider is configured.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resolveEntities(ctx, r.client, req.Config, &resp.Plan)...)
+}
+
 // Create this function is responsible for creating a new resource of type Entitle Workflow.
 //
 // Its reads the Terraform plan data provided in req.Plan and maps it to the WorkflowResourceModel.
@@ -460,8 +514,12 @@ func (r *WorkflowResource) Create(
 		return
 	}
 
-	err = utils.HTTPResponseToError(workflowResp.HTTPResponse.StatusCode, workflowResp.Body)
+	err = utils.ResponseToError(workflowResp.HTTPResponse, workflowResp.Body)
 	if err != nil {
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, workflowFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -491,6 +549,8 @@ func (r *WorkflowResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Read this function is used to read an existing resource of type Entitle Workflow.
@@ -524,7 +584,7 @@ func (r *WorkflowResource) Read(
 		return
 	}
 
-	err = utils.HTTPResponseToError(workflowResp.HTTPResponse.StatusCode, workflowResp.Body)
+	err = utils.ResponseToError(workflowResp.HTTPResponse, workflowResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -559,6 +619,8 @@ func (r *WorkflowResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Update this function handles updates to an existing resource of type Entitle Workflow.
@@ -603,7 +665,7 @@ func (r *WorkflowResource) Update(
 		return
 	}
 
-	err = utils.HTTPResponseToError(workflowResp.HTTPResponse.StatusCode, workflowResp.Body)
+	err = utils.ResponseToError(workflowResp.HTTPResponse, workflowResp.Body)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			tflog.Debug(ctx, "Resource no longer exists, removing from state")
@@ -612,6 +674,10 @@ func (r *WorkflowResource) Update(
 			return
 		}
 
+		if utils.AddAttributeErrors(&resp.Diagnostics, err, workflowFieldPaths) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
 			fmt.Sprintf(
@@ -638,6 +704,8 @@ func (r *WorkflowResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.State, resp.Identity, utils.IDIdentityPaths)...)
 }
 
 // Delete this function is responsible for deleting an existing resource of type
@@ -670,7 +738,7 @@ func (r *WorkflowResource) Delete(
 		return
 	}
 
-	err = utils.HTTPResponseToError(httpResp.HTTPResponse.StatusCode, httpResp.Body, utils.WithIgnoreNotFound())
+	err = utils.ResponseToError(httpResp.HTTPResponse, httpResp.Body, utils.WithIgnoreNotFound())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			utils.ErrApiResponse.Error(),
@@ -685,14 +753,22 @@ func (r *WorkflowResource) Delete(
 	}
 }
 
+// IdentitySchema defines the identity of the resource, its Entitle ID.
+func (r *WorkflowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ImportState this function is used to import an existing resource's state into Terraform.
 //
-// It extracts the resource's identifier from the import request and sets
-// it in Terraform state using resource.ImportStatePassthroughID.
+// The import ID is the workflow's UUID or name:<workflow name>.
 func (r *WorkflowResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateByID(ctx, req, resp, utils.ImportResolvers{
+		Name: func(ctx context.Context, name string) (*uuid.UUID, error) {
+			return utils.FindWorkflowIDByName(ctx, r.client, name)
+		},
+	})
 }
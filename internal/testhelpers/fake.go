@@ -0,0 +1,36 @@
+package testhelpers
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/fakeentitle"
+	"github.com/entitleio/terraform-provider-entitle/internal/provider"
+)
+
+var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"entitle": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+// NewFakeServer starts an in-memory Entitle API for a resource.UnitTest and
+// returns it with a provider configuration pointing at it. The test is
+// skipped when no Terraform CLI is available, since resource.UnitTest would
+// otherwise try to download one.
+func NewFakeServer(t *testing.T) (*fakeentitle.Server, string) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run hermetic resource tests")
+		}
+	}
+
+	srv := fakeentitle.NewServer()
+	t.Cleanup(srv.Close)
+
+	return srv, srv.ProviderConfig()
+}
@@ -5,11 +5,6 @@ package testhelpers
 import (
 	"fmt"
 	"os"
-
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-
-	"github.com/entitleio/terraform-provider-entitle/internal/provider"
 )
 
 var (
@@ -25,7 +20,4 @@ provider "entitle" {
   api_key  = "%s"
 }
 `, os.Getenv("ENTITLE_DOMAIN"), os.Getenv("ENTITLE_API_KEY2"))
-	TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"entitle": providerserver.NewProtocol6WithError(provider.New("test")()),
-	}
 )
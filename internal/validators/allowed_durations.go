@@ -0,0 +1,117 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/entitleio/terraform-provider-entitle/internal/client"
+)
+
+var _ validator.Set = &AllowedDurations{}
+var _ validator.Set = &AllowedDurationLabels{}
+
+// AllowedDurations validator.Set for sets of durations in seconds, which must
+// be client.EnumAllowedDurations values.
+type AllowedDurations struct{}
+
+// Description satisfies the validator.Set interface.
+func (a AllowedDurations) Description(ctx context.Context) string {
+	return "validating every value is one of " + AllowedDurationValues()
+}
+
+// MarkdownDescription satisfies the validator.Set interface.
+func (a AllowedDurations) MarkdownDescription(ctx context.Context) string {
+	return a.Description(ctx)
+}
+
+// ValidateSet satisfies the validator.Set interface.
+func (a AllowedDurations) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, item := range req.ConfigValue.Elements() {
+		val, ok := item.(types.Number)
+		if !ok || val.IsUnknown() || val.IsNull() {
+			continue
+		}
+
+		f, _ := val.ValueBigFloat().Float64()
+		if !IsAllowedDuration(f) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Allowed Duration",
+				fmt.Sprintf("%s is not an allowed duration, expected one of %s.", val.ValueBigFloat().Text('f', -1), AllowedDurationValues()),
+			)
+		}
+	}
+}
+
+// AllowedDurationLabels validator.Set for sets of duration labels, which must
+// be labels of client.EnumAllowedDurations values.
+type AllowedDurationLabels struct{}
+
+// Description satisfies the validator.Set interface.
+func (a AllowedDurationLabels) Description(ctx context.Context) string {
+	return "validating every value is one of " + allowedDurationLabels()
+}
+
+// MarkdownDescription satisfies the validator.Set interface.
+func (a AllowedDurationLabels) MarkdownDescription(ctx context.Context) string {
+	return a.Description(ctx)
+}
+
+// ValidateSet satisfies the validator.Set interface.
+func (a AllowedDurationLabels) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, item := range req.ConfigValue.Elements() {
+		val, ok := item.(types.String)
+		if !ok || val.IsUnknown() || val.IsNull() {
+			continue
+		}
+
+		if _, ok := client.EnumAllowedDurationsFromLabel(val.ValueString()); !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Allowed Duration Label",
+				fmt.Sprintf("%q is not an allowed duration label, expected one of %s.", val.ValueString(), allowedDurationLabels()),
+			)
+		}
+	}
+}
+
+// IsAllowedDuration reports whether seconds is a client.EnumAllowedDurations
+// value.
+func IsAllowedDuration(seconds float64) bool {
+	_, ok := client.EnumAllowedDurations(seconds).Label()
+
+	return ok && float64(float32(seconds)) == seconds
+}
+
+// AllowedDurationValues lists the allowed durations in seconds.
+func AllowedDurationValues() string {
+	values := make([]string, len(client.EnumAllowedDurationsLabels))
+	for i, l := range client.EnumAllowedDurationsLabels {
+		values[i] = strconv.FormatFloat(float64(l.Value), 'f', -1, 32)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// allowedDurationLabels lists the allowed duration labels.
+func allowedDurationLabels() string {
+	labels := make([]string, len(client.EnumAllowedDurationsLabels))
+	for i, l := range client.EnumAllowedDurationsLabels {
+		labels[i] = l.Label
+	}
+
+	return strings.Join(labels, ", ")
+}
@@ -0,0 +1,50 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &Duration{}
+
+// Duration validator.String for Go duration strings such as "90s", "15m" or "1h30m".
+type Duration struct{}
+
+// Description satisfies the validator.String interface.
+func (d Duration) Description(ctx context.Context) string {
+	return "validating the value is a positive duration string (e.g. 30s, 15m, 1h)"
+}
+
+// MarkdownDescription satisfies the validator.String interface.
+func (d Duration) MarkdownDescription(ctx context.Context) string {
+	return "validating the value is a positive duration string (e.g. `30s`, `15m`, `1h`)"
+}
+
+// ValidateString Validate satisfies the validator.String interface.
+func (d Duration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		// skip validation when the value is not known yet
+		return
+	}
+
+	v, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Duration Validate failed",
+			fmt.Sprintf("failed to parse duration (%s), error: %v", req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+
+	if v <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Duration Validate failed",
+			fmt.Sprintf("duration (%s) must be greater than zero", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,39 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &Regex{}
+
+// Regex validator.String for regular expressions in Go's RE2 syntax.
+type Regex struct{}
+
+// Description satisfies the validator.String interface.
+func (r Regex) Description(ctx context.Context) string {
+	return "validating the value is a valid regular expression"
+}
+
+// MarkdownDescription satisfies the validator.String interface.
+func (r Regex) MarkdownDescription(ctx context.Context) string {
+	return r.Description(ctx)
+}
+
+// ValidateString satisfies the validator.String interface.
+func (r Regex) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("%q is not a valid regular expression: %v", req.ConfigValue.ValueString(), err),
+		)
+	}
+}